@@ -0,0 +1,213 @@
+package LOLTalentScout
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go.uber.org/zap"
+	"main.go/config"
+	"main.go/metrics"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// APITokenHeader 调用HTTP接口时携带令牌的请求头
+	APITokenHeader = "X-TalentScout-Token"
+	// apiTokenFile 令牌文件名，和配置文件放在同一目录
+	apiTokenFile = "api.token"
+)
+
+// Status 伯乐当前状态
+type Status struct {
+	GameState  GameState `json:"gameState"`
+	LCUActive  bool      `json:"lcuActive"`  // 是否已连接客户端
+	Summoner   string    `json:"summoner"`   // 当前召唤师
+	AutoAccept bool      `json:"autoAccept"` // 是否自动接受对局
+	Pending    string    `json:"pending"`    // 等待确认的操作，为空表示没有
+}
+
+// AutoAcceptReq 修改自动接受对局
+type AutoAcceptReq struct {
+	Enabled bool `json:"enabled"`
+}
+
+// Status 当前状态
+func (ts *TalentScout) Status() Status {
+	ts.mu.Lock()
+	status := Status{
+		GameState:  ts.GameState,
+		LCUActive:  ts.lcuActive,
+		AutoAccept: ts.autoAccept,
+	}
+	ts.mu.Unlock()
+	if ts.currSummoner != nil {
+		status.Summoner = ts.currSummoner.GameName + "#" + ts.currSummoner.TagLine
+	}
+	if h, ok := ts.ui.(*headlessUI); ok {
+		status.Pending = h.Pending()
+	}
+	return status
+}
+
+// startAPI 开启HTTP接口，用于查看状态、切换开关和采集指标
+func (ts *TalentScout) startAPI(addr string) error {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/status", func(w http.ResponseWriter, r *http.Request) {
+		writeAPIResp(w, http.StatusOK, ts.Status())
+	})
+	mux.HandleFunc("GET /api/auto-accept", func(w http.ResponseWriter, r *http.Request) {
+		writeAPIResp(w, http.StatusOK, AutoAcceptReq{Enabled: ts.Status().AutoAccept})
+	})
+	mux.HandleFunc("PUT /api/auto-accept", func(w http.ResponseWriter, r *http.Request) {
+		req := AutoAcceptReq{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeAPIResp(w, http.StatusBadRequest, apiError{Error: "请求格式错误:" + err.Error()})
+			return
+		}
+		ts.updateAutoAccept(req.Enabled)
+		ts.ui.SetAutoAccept(req.Enabled)
+		writeAPIResp(w, http.StatusOK, req)
+	})
+	mux.HandleFunc("GET /api/alerts", func(w http.ResponseWriter, r *http.Request) {
+		writeAPIResp(w, http.StatusOK, ts.Alerts())
+	})
+	mux.HandleFunc("GET /api/live", func(w http.ResponseWriter, r *http.Request) {
+		snapshot, ok := ts.LiveGame()
+		if !ok {
+			writeAPIResp(w, http.StatusNotFound, apiError{Error: "当前不在游戏中"})
+			return
+		}
+		writeAPIResp(w, http.StatusOK, snapshot)
+	})
+	mux.HandleFunc("GET /api/timers", func(w http.ResponseWriter, r *http.Request) {
+		status, err := ts.Timers()
+		if err != nil {
+			writeAPIResp(w, http.StatusNotFound, apiError{Error: err.Error()})
+			return
+		}
+		writeAPIResp(w, http.StatusOK, status)
+	})
+	mux.HandleFunc("POST /api/timers/mark", func(w http.ResponseWriter, r *http.Request) {
+		req := MarkSpellReq{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeAPIResp(w, http.StatusBadRequest, apiError{Error: "请求格式错误:" + err.Error()})
+			return
+		}
+		timer, err := ts.MarkEnemySpell(req.Target, req.Spell)
+		switch {
+		case errors.Is(err, ErrNotInGame):
+			writeAPIResp(w, http.StatusNotFound, apiError{Error: err.Error()})
+		case err != nil:
+			writeAPIResp(w, http.StatusBadRequest, apiError{Error: err.Error()})
+		default:
+			writeAPIResp(w, http.StatusOK, timer)
+		}
+	})
+	mux.HandleFunc("POST /api/confirm", func(w http.ResponseWriter, r *http.Request) {
+		h, ok := ts.ui.(*headlessUI)
+		if !ok || !h.Confirm() {
+			writeAPIResp(w, http.StatusConflict, apiError{Error: "没有等待确认的操作"})
+			return
+		}
+		writeAPIResp(w, http.StatusOK, apiError{})
+	})
+	mux.Handle("GET /metrics", metrics.Handler())
+	token, err := ensureAPIToken(APITokenPath(config.Path()))
+	if err != nil {
+		return fmt.Errorf("生成接口令牌失败: %w", err)
+	}
+	// 先监听端口，地址被占用时直接返回错误
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	ts.httpSrv = &http.Server{Handler: apiGuard(token, mux)}
+	go func() {
+		if err := ts.httpSrv.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			ts.log.Error("HTTP接口异常退出", zap.Error(err))
+		}
+	}()
+	fmt.Println("HTTP接口已开启:", "http://"+listener.Addr().String()+"/api/status")
+	return nil
+}
+
+// apiError 接口错误信息
+type apiError struct {
+	Error string `json:"error,omitempty"`
+}
+
+func writeAPIResp(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// apiGuard 拦截网页发来的跨站请求：/api/下的接口必须带令牌，带Origin的必须和接口同源，修改类请求必须是json
+// 浏览器不能给跨站的简单请求加自定义请求头，所以任意网页都调用不了确认秒退等接口
+func apiGuard(token string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if origin := r.Header.Get("Origin"); origin != "" {
+			if u, err := url.Parse(origin); err != nil || u.Host != r.Host {
+				writeAPIResp(w, http.StatusForbidden, apiError{Error: "不允许跨站调用"})
+				return
+			}
+		}
+		if !strings.HasPrefix(r.URL.Path, "/api/") {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get(APITokenHeader)), []byte(token)) != 1 {
+			writeAPIResp(w, http.StatusUnauthorized,
+				apiError{Error: "缺少或错误的接口令牌,请在请求头" + APITokenHeader + "中携带" + apiTokenFile + "的内容"})
+			return
+		}
+		if r.Method != http.MethodGet {
+			if mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type")); err != nil ||
+				mediaType != "application/json" {
+				writeAPIResp(w, http.StatusUnsupportedMediaType, apiError{Error: "Content-Type必须是application/json"})
+				return
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// APITokenPath 接口令牌文件的路径，和配置文件放在同一目录
+func APITokenPath(configPath string) string {
+	return filepath.Join(filepath.Dir(configPath), apiTokenFile)
+}
+
+// LoadAPIToken 读取接口令牌，ctl调用接口时使用
+func LoadAPIToken(configPath string) (string, error) {
+	bts, err := os.ReadFile(APITokenPath(configPath))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(bts)), nil
+}
+
+// ensureAPIToken 读取令牌文件，不存在时随机生成一个，重启后沿用
+func ensureAPIToken(path string) (string, error) {
+	if bts, err := os.ReadFile(path); err == nil {
+		if token := strings.TrimSpace(string(bts)); token != "" {
+			return token, nil
+		}
+	} else if !os.IsNotExist(err) {
+		return "", err
+	}
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	token := hex.EncodeToString(b)
+	return token, os.WriteFile(path, []byte(token+"\n"), 0o600)
+}
@@ -0,0 +1,293 @@
+package backtest
+
+import (
+	"cmp"
+	"fmt"
+	"main.go/config"
+	"main.go/lcu/models"
+	"main.go/scores"
+	"math"
+	"slices"
+	"strings"
+)
+
+// 评级从低到高，和scores.Judge一致
+var labelOrder = []string{"纯牛马", "下等马", "中等马", "上等马", "小代", "通天代"}
+
+type (
+	// Variant 一个参与回测的评分模型
+	Variant struct {
+		Name   string
+		Scorer scores.Scorer
+	}
+	// Options 回测参数
+	Options struct {
+		HistorySize        int                  // 每名玩家赛前参考的对局数，和实时评分一样取最近20场
+		MinGameDurationSec int                  // 短于该时长的对局不参与评分
+		MinHistory         int                  // 赛前至少有几局记录才计入该玩家
+		MinPlayers         int                  // 每队至少几名玩家有赛前评分，不足的对局跳过
+		Scale              float64              // 双方评分差换算胜率的尺度，和胜率预估一致
+		Buckets            int                  // 校准分桶数
+		Shrinkage          config.ShrinkageConf // 低样本评分收缩，缓存对局里没有段位，只使用统一先验
+	}
+	// Bucket 校准分桶，预测胜率落在[Low,High)的对局
+	Bucket struct {
+		Low           float64 `json:"low"`
+		High          float64 `json:"high"`
+		Count         int     `json:"count"`
+		MeanPredicted float64 `json:"meanPredicted"` // 平均预测胜率
+		WinRate       float64 `json:"winRate"`       // 实际胜率
+	}
+	// LabelStat 赛前评级对应的玩家所在队伍实际胜率
+	LabelStat struct {
+		Label   string  `json:"label"`
+		Players int     `json:"players"`
+		WinRate float64 `json:"winRate"`
+	}
+	// Report 一组得分标准的回测结果，胜率均以蓝色方视角计算
+	Report struct {
+		Variant     string      `json:"variant"`
+		Scorer      string      `json:"scorer"`  // 评分模型和加权策略说明
+		Games       int         `json:"games"`   // 参与评估的对局数
+		Skipped     int         `json:"skipped"` // 赛前数据不足跳过的对局数
+		Accuracy    float64     `json:"accuracy"`
+		LogLoss     float64     `json:"logLoss"`
+		Brier       float64     `json:"brier"`
+		Calibration []Bucket    `json:"calibration"`
+		Labels      []LabelStat `json:"labels"`
+	}
+)
+
+// DefaultOptions 默认回测参数
+func DefaultOptions() Options {
+	return Options{
+		HistorySize:        20,
+		MinGameDurationSec: 15 * 60,
+		MinHistory:         1,
+		MinPlayers:         1,
+		Scale:              15,
+		Buckets:            10,
+		Shrinkage:          config.Default().Shrinkage,
+	}
+}
+
+// playerGame 某名玩家参与的一局
+type playerGame struct {
+	gameIdx       int
+	participantID int
+}
+
+// dataset 按时间排序的对局和每名玩家的参与记录
+type dataset struct {
+	games   []models.GameSummary
+	players map[string][]playerGame // puuid -> 从旧到新
+}
+
+// newDataset 过滤掉不支持的队列并按对局开始时间排序
+func newDataset(games []models.GameSummary) *dataset {
+	list := make([]models.GameSummary, 0, len(games))
+	for _, game := range games {
+		if slices.Contains(scores.ScoreQueueIDs, models.GameQueueID(game.QueueId)) && game.GameDuration > 0 {
+			list = append(list, game)
+		}
+	}
+	slices.SortFunc(list, func(a, b models.GameSummary) int {
+		return a.GameCreationDate.Compare(b.GameCreationDate)
+	})
+	d := &dataset{
+		games:   list,
+		players: make(map[string][]playerGame),
+	}
+	for i, game := range list {
+		for _, identity := range game.ParticipantIdentities {
+			if identity.Player.Puuid == "" {
+				continue
+			}
+			d.players[identity.Player.Puuid] = append(d.players[identity.Player.Puuid], playerGame{
+				gameIdx:       i,
+				participantID: identity.ParticipantId,
+			})
+		}
+	}
+	return d
+}
+
+// priorGames 玩家在第gameIdx局之前最近的对局
+func (d *dataset) priorGames(puuid string, gameIdx, limit int) []playerGame {
+	list := d.players[puuid]
+	end, _ := slices.BinarySearchFunc(list, gameIdx, func(item playerGame, target int) int {
+		return cmp.Compare(item.gameIdx, target)
+	})
+	return list[max(0, end-limit):end]
+}
+
+// Run 用缓存对局回测每个评分模型：每局开赛前只用更早的对局给10名玩家评分，再用双方平均评分差预测胜负
+func Run(games []models.GameSummary, variants []Variant, opts Options) []Report {
+	d := newDataset(games)
+	reports := make([]Report, 0, len(variants))
+	for _, variant := range variants {
+		reports = append(reports, d.run(variant, opts))
+	}
+	return reports
+}
+
+func (d *dataset) run(variant Variant, opts Options) Report {
+	report := Report{Variant: variant.Name, Scorer: variant.Scorer.String()}
+	gameScores := make(map[playerGame]float64)
+	// gameScore 单局得分只和评分模型有关，同一局会被多名玩家的赛前评分重复用到
+	gameScore := func(item playerGame) (float64, bool) {
+		if score, ok := gameScores[item]; ok {
+			return score, true
+		}
+		score, err := variant.Scorer.GameScore(item.participantID, d.games[item.gameIdx])
+		if err != nil {
+			return 0, false
+		}
+		gameScores[item] = score
+		return score, true
+	}
+	buckets := make([]Bucket, max(opts.Buckets, 1))
+	for i := range buckets {
+		buckets[i].Low = float64(i) / float64(len(buckets))
+		buckets[i].High = float64(i+1) / float64(len(buckets))
+	}
+	labelMap := make(map[string]*LabelStat)
+	correct := 0.0
+	for i, game := range d.games {
+		teamRatings := map[models.TeamID][]float64{}
+		teamWin := map[models.TeamID]bool{}
+		ratedWins := make(map[string][]bool)
+		participantTeam := make(map[int]models.Participant, len(game.Participants))
+		for _, participant := range game.Participants {
+			participantTeam[participant.ParticipantId] = participant
+			teamWin[participant.TeamId] = participant.Stats.Win
+		}
+		for _, identity := range game.ParticipantIdentities {
+			if identity.Player.Puuid == "" {
+				continue
+			}
+			items := make([]scores.GameScoreItem, 0, opts.HistorySize)
+			for _, prior := range d.priorGames(identity.Player.Puuid, i, opts.HistorySize) {
+				priorGame := d.games[prior.gameIdx]
+				if priorGame.GameDuration < opts.MinGameDurationSec {
+					continue
+				}
+				if score, ok := gameScore(prior); ok {
+					items = append(items, scores.GameScoreItem{
+						Score:            score,
+						GameCreationDate: priorGame.GameCreationDate,
+						GameVersion:      priorGame.GameVersion,
+					})
+				}
+			}
+			if len(items) < max(opts.MinHistory, 1) {
+				continue
+			}
+			stat := variant.Scorer.Aggregate(items, game.GameCreationDate)
+			rating, _, _ := scores.Shrink(stat, opts.Shrinkage.PriorScore, opts.Shrinkage)
+			participant := participantTeam[identity.ParticipantId]
+			teamRatings[participant.TeamId] = append(teamRatings[participant.TeamId], rating)
+			label := scores.Judge(rating)
+			ratedWins[label] = append(ratedWins[label], participant.Stats.Win)
+		}
+		blue, red := teamRatings[models.TeamIDBlue], teamRatings[models.TeamIDRed]
+		if len(blue) < max(opts.MinPlayers, 1) || len(red) < max(opts.MinPlayers, 1) {
+			report.Skipped++
+			continue
+		}
+		for label, wins := range ratedWins {
+			stat, ok := labelMap[label]
+			if !ok {
+				stat = &LabelStat{Label: label}
+				labelMap[label] = stat
+			}
+			for _, win := range wins {
+				stat.Players++
+				if win {
+					stat.WinRate++
+				}
+			}
+		}
+		diff := mean(blue) - mean(red)
+		predicted := winProbability(diff, opts.Scale)
+		actual := 0.0
+		if teamWin[models.TeamIDBlue] {
+			actual = 1
+		}
+		switch {
+		case diff == 0:
+			correct += .5
+		case (diff > 0) == teamWin[models.TeamIDBlue]:
+			correct++
+		}
+		clamped := min(max(predicted, 1e-6), 1-1e-6)
+		report.LogLoss -= actual*math.Log(clamped) + (1-actual)*math.Log(1-clamped)
+		report.Brier += (predicted - actual) * (predicted - actual)
+		bucket := &buckets[min(int(predicted*float64(len(buckets))), len(buckets)-1)]
+		bucket.Count++
+		bucket.MeanPredicted += predicted
+		bucket.WinRate += actual
+		report.Games++
+	}
+	if report.Games > 0 {
+		report.Accuracy = correct / float64(report.Games)
+		report.LogLoss /= float64(report.Games)
+		report.Brier /= float64(report.Games)
+	}
+	for _, bucket := range buckets {
+		if bucket.Count == 0 {
+			continue
+		}
+		bucket.MeanPredicted /= float64(bucket.Count)
+		bucket.WinRate /= float64(bucket.Count)
+		report.Calibration = append(report.Calibration, bucket)
+	}
+	for _, stat := range labelMap {
+		stat.WinRate /= float64(stat.Players)
+		report.Labels = append(report.Labels, *stat)
+	}
+	// 按评级从低到高，方便看胜率是否随评级上升
+	slices.SortFunc(report.Labels, func(a, b LabelStat) int {
+		return cmp.Compare(slices.Index(labelOrder, a.Label), slices.Index(labelOrder, b.Label))
+	})
+	return report
+}
+
+// String 回测报告文本
+func (r Report) String() string {
+	sb := strings.Builder{}
+	sb.WriteString(fmt.Sprintf("===== %s =====\n", r.Variant))
+	sb.WriteString(fmt.Sprintf("评分模型:%s\n", r.Scorer))
+	sb.WriteString(fmt.Sprintf("对局:%d 跳过:%d\n", r.Games, r.Skipped))
+	if r.Games == 0 {
+		sb.WriteString("没有可评估的对局\n")
+		return sb.String()
+	}
+	sb.WriteString(fmt.Sprintf("准确率:%.1f%% 对数损失:%.4f Brier:%.4f (全部猜50%%时为0.6931/0.2500)\n",
+		r.Accuracy*100, r.LogLoss, r.Brier))
+	sb.WriteString("校准(预测胜率区间 对局数 平均预测 实际胜率):\n")
+	for _, bucket := range r.Calibration {
+		sb.WriteString(fmt.Sprintf("  %3.0f%%~%3.0f%%\t%d\t%.1f%%\t%.1f%%\n", bucket.Low*100, bucket.High*100,
+			bucket.Count, bucket.MeanPredicted*100, bucket.WinRate*100))
+	}
+	sb.WriteString("赛前评级(评级 人次 所在队伍实际胜率):\n")
+	for _, stat := range r.Labels {
+		sb.WriteString(fmt.Sprintf("  %s\t%d\t%.1f%%\n", stat.Label, stat.Players, stat.WinRate*100))
+	}
+	return sb.String()
+}
+
+func mean(list []float64) float64 {
+	total := 0.0
+	for _, v := range list {
+		total += v
+	}
+	return total / float64(len(list))
+}
+
+func winProbability(diff, scale float64) float64 {
+	if scale <= 0 {
+		scale = 1
+	}
+	return 1 / (1 + math.Exp(-diff/scale))
+}
@@ -0,0 +1,106 @@
+package backtest
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"main.go/config"
+	"main.go/scores"
+	"main.go/store"
+	"path/filepath"
+	"strings"
+)
+
+// Command 回测子命令，用法: backtest [-conf a.yaml,b.yaml] [-scorers perminute,zscore] [-history 20] [-min-players 1]
+func Command(args []string) error {
+	fs := flag.NewFlagSet("backtest", flag.ContinueOnError)
+	confPath := fs.String("config", config.DefaultPath, "配置文件路径")
+	variantPaths := fs.String("conf", "", "参与对比的得分标准yaml文件(经典模型)，多个用逗号分隔，当前得分标准总会参与")
+	scorerNames := fs.String("scorers", "", "参与对比的其他评分模型，多个用逗号分隔，可选:"+strings.Join(scores.ScorerNames(), ","))
+	opts := DefaultOptions()
+	fs.IntVar(&opts.HistorySize, "history", opts.HistorySize, "每名玩家赛前参考的对局数")
+	fs.IntVar(&opts.MinHistory, "min-history", opts.MinHistory, "赛前至少有几局记录才计入该玩家")
+	fs.IntVar(&opts.MinPlayers, "min-players", opts.MinPlayers, "每队至少几名玩家有赛前评分")
+	fs.IntVar(&opts.Buckets, "buckets", opts.Buckets, "校准分桶数")
+	strategy := fs.String("weighting", "", "对局加权策略 window/decay，默认使用配置文件")
+	halfLife := fs.Float64("half-life", 0, "decay策略的半衰期(小时)，默认使用配置文件")
+	maxGames := fs.Int("max-games", -1, "只取最近的几局，默认使用配置文件")
+	patchDecay := fs.Float64("patch-decay", 0, "每旧一个版本的权重系数，默认使用配置文件")
+	noShrink := fs.Bool("no-shrink", false, "关闭低样本评分收缩")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := config.Init(*confPath); err != nil {
+		return err
+	}
+	conf := config.Get()
+	store.Init(conf.DataDir)
+	opts.Scale = conf.WinRate.Scale
+	opts.Shrinkage = conf.Shrinkage
+	// 命令行参数覆盖配置文件中的加权策略，方便对比不同策略
+	weightingConf := conf.Weighting
+	if *strategy != "" {
+		weightingConf.Strategy = *strategy
+	}
+	if *halfLife > 0 {
+		weightingConf.HalfLifeHours = *halfLife
+	}
+	if *maxGames >= 0 {
+		weightingConf.MaxGames = *maxGames
+	}
+	if *patchDecay > 0 {
+		weightingConf.PatchDecay = *patchDecay
+	}
+	weighting := scores.NewWeighting(weightingConf)
+	if *noShrink {
+		opts.Shrinkage.Enabled = false
+	}
+
+	variants, err := loadVariants(*variantPaths, *scorerNames, weighting)
+	if err != nil {
+		return err
+	}
+	games, err := store.LoadGames()
+	if err != nil {
+		return err
+	}
+	if len(games) == 0 {
+		return errors.New("没有缓存的对局，先正常使用一段时间积累对局数据")
+	}
+	fmt.Printf("读取缓存对局%d局\n", len(games))
+	for _, report := range Run(games, variants, opts) {
+		fmt.Println(report.String())
+	}
+	return nil
+}
+
+// loadVariants 当前得分标准加上命令行指定的得分标准文件和评分模型
+func loadVariants(paths, scorerNames string, weighting scores.Weighting) ([]Variant, error) {
+	variants := []Variant{{Name: "default", Scorer: scores.NewClassicScorer(scores.CurrCalcScoreConf(), weighting)}}
+	for _, path := range strings.Split(paths, ",") {
+		path = strings.TrimSpace(path)
+		if path == "" {
+			continue
+		}
+		calcScoreConf, err := scores.LoadCalcScoreConf(path)
+		if err != nil {
+			return nil, fmt.Errorf("读取得分标准%s失败: %w", path, err)
+		}
+		variants = append(variants, Variant{
+			Name:   strings.TrimSuffix(filepath.Base(path), filepath.Ext(path)),
+			Scorer: scores.NewClassicScorer(calcScoreConf, weighting),
+		})
+	}
+	for _, name := range strings.Split(scorerNames, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		scorer, err := scores.NewScorer(name, weighting)
+		if err != nil {
+			return nil, err
+		}
+		variants = append(variants, Variant{Name: name, Scorer: scorer})
+	}
+	return variants, nil
+}
@@ -1,24 +1,47 @@
+//go:build !headless
+
 package checkBox
 
 import (
-	"fmt"
 	"github.com/getlantern/systray"
+	"time"
 )
 
 var (
 	// 创建一个复选框菜单项
 	acceptItem *systray.MenuItem
-	isAccept   bool // 记录当前勾选状态
+	isAccept   bool       // 记录当前勾选状态
+	onAccept   func(bool) // 勾选状态变化时通知伯乐
+	// 最近一条玩家提醒
+	alertItem *systray.MenuItem
+	// 需要用户确认的操作，例如秒退
+	confirmItem *systray.MenuItem
 )
 
+// Start 启动通知栏，autoAccept为初始勾选状态，切换勾选时调用onToggle
+func Start(autoAccept bool, onToggle func(bool)) {
+	isAccept = autoAccept
+	onAccept = onToggle
+	go systray.Run(OnStart, OnExit)
+}
+
+// Stop 关闭通知栏
+func Stop() {
+	systray.Quit()
+}
+
 func OnStart() {
 	systray.SetIcon(Icon)
 	systray.SetTitle("LOLTalentScout")
 	systray.SetTooltip("LOLTalentScout")
 	// 创建复选框菜单项
-	acceptItem = systray.AddMenuItemCheckbox("自动接受对局", "点击切换勾选状态", true)
-	// 初始化状态
-	acceptItem.Check()
+	acceptItem = systray.AddMenuItemCheckbox("自动接受对局", "点击切换勾选状态", isAccept)
+	// 玩家提醒只用来展示，默认隐藏
+	alertItem = systray.AddMenuItem("", "最近一条玩家提醒")
+	alertItem.Disable()
+	alertItem.Hide()
+	confirmItem = systray.AddMenuItem("", "点击确认")
+	confirmItem.Hide()
 	// 监听菜单项点击事件
 	go func() {
 		for {
@@ -32,16 +55,52 @@ func OnStart() {
 					acceptItem.Check()
 					isAccept = true
 				}
-				//提醒用户
-				if isAccept {
-					fmt.Println("已设置自动接受对局")
-				} else {
-					fmt.Println("已取消自动接受对局")
+				if onAccept != nil {
+					onAccept(isAccept)
 				}
 			}
 		}
 	}()
 }
 
+// SetAccept 同步自动接受对局的勾选状态
+func SetAccept(flag bool) {
+	isAccept = flag
+	if acceptItem == nil {
+		return
+	}
+	if flag {
+		acceptItem.Check()
+	} else {
+		acceptItem.Uncheck()
+	}
+}
+
 func OnExit() {
 }
+
+// Notify 在通知栏展示玩家提醒
+func Notify(msg string) {
+	if alertItem == nil {
+		return
+	}
+	systray.SetTooltip(msg)
+	alertItem.SetTitle(msg)
+	alertItem.Show()
+}
+
+// AskConfirm 在通知栏展示确认按钮，超时未点击返回false
+func AskConfirm(title string, timeout time.Duration) bool {
+	if confirmItem == nil {
+		return false
+	}
+	confirmItem.SetTitle(title)
+	confirmItem.Show()
+	defer confirmItem.Hide()
+	select {
+	case <-confirmItem.ClickedCh:
+		return true
+	case <-time.After(timeout):
+		return false
+	}
+}
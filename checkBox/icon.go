@@ -1,3 +1,5 @@
+//go:build !headless
+
 package checkBox
 
 var Icon = []byte{0, 0, 1, 0, 1, 0, 80, 80, 0, 0, 1, 0, 32, 0, 232, 103, 0, 0, 22, 0, 0, 0, 40, 0, 0, 0, 80, 0, 0, 0, 160, 0, 0, 0, 1, 0, 32, 0, 0, 0, 0, 0, 0, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 75, 104, 132, 255, 112, 146, 175, 255, 112, 147, 176, 255, 112, 148, 178, 255, 114, 151, 180, 255, 112, 154, 181, 255, 115, 156, 186, 255, 114, 155, 184, 255, 119, 160, 191, 255, 123, 161, 192, 255, 118, 157, 181, 255, 115, 152, 168, 255, 110, 148, 151, 255, 112, 150, 154, 255, 122, 155, 158, 255, 127, 161, 161, 255, 123, 159, 150, 255, 123, 160, 151, 255, 130, 163, 162, 255, 123, 157, 158, 255, 90, 123, 101, 255, 82, 119, 94, 255, 88, 126, 104, 255, 84, 119, 92, 255, 74, 111, 83, 255, 77, 113, 85, 255, 83, 119, 90, 255, 87, 126, 96, 255, 96, 137, 110, 255, 98, 138, 112, 255, 101, 135, 112, 255, 93, 127, 103, 255, 87, 119, 91, 255, 83, 116, 86, 255, 81, 110, 83, 255, 83, 109, 85, 255, 100, 132, 120, 255, 98, 137, 123, 255, 79, 115, 90, 255, 82, 119, 93, 255, 88, 129, 102, 255, 95, 135, 106, 255, 97, 133, 113, 255, 89, 122, 102, 255, 75, 111, 83, 255, 81, 122, 99, 255, 100, 145, 147, 255, 112, 158, 161, 255, 117, 168, 183, 255, 113, 162, 172, 255, 120, 168, 190, 255, 119, 171, 194, 255, 114, 166, 185, 255, 114, 174, 204, 255, 110, 172, 202, 255, 109, 174, 202, 255, 106, 168, 193, 255, 106, 167, 190, 255, 113, 169, 193, 255, 116, 167, 191, 255, 116, 165, 189, 255, 145, 177, 199, 255, 154, 181, 202, 255, 148, 181, 200, 255, 144, 177, 198, 255, 140, 173, 194, 255, 136, 169, 190, 255, 129, 161, 182, 255, 122, 154, 175, 255, 117, 149, 171, 255, 115, 148, 170, 255, 113, 144, 167, 255, 113, 143, 167, 255, 113, 146, 169, 255, 113, 143, 166, 255, 111, 142, 164, 255, 106, 136, 158, 255, 104, 135, 156, 255, 101, 133, 154, 255, 98, 131, 151, 255, 100, 135, 159, 255, 106, 138, 165, 255, 101, 132, 163, 255, 101, 136, 165, 255, 98, 134, 165, 255, 96, 137, 166, 255, 96, 136, 162, 255, 99, 138, 155, 255, 97, 133, 135, 255, 89, 122, 109, 255, 75, 109, 85, 255, 74, 107, 77, 255, 73, 105, 69, 255, 79, 113, 76, 255, 74, 103, 71, 255, 66, 94, 65, 255, 57, 86, 59, 255, 56, 82, 57, 255, 61, 90, 62, 255, 79, 114, 79, 255, 77, 112, 75, 255, 73, 109, 73, 255, 75, 109, 74, 255, 78, 110, 79, 255, 77, 114, 81, 255, 79, 115, 82, 255, 82, 116, 83, 255, 84, 121, 86, 255, 85, 121, 86, 255, 87, 120, 85, 255, 84, 116, 81, 255, 83, 115, 78, 255, 82, 115, 78, 255, 81, 112, 78, 255, 82, 111, 77, 255, 82, 109, 76, 255, 77, 103, 68, 255, 73, 103, 67, 255, 77, 106, 72, 255, 76, 107, 71, 255, 78, 113, 75, 255, 30, 50, 50, 255, 14, 26, 41, 255, 17, 26, 46, 255, 20, 30, 53, 255, 19, 29, 53, 255, 16, 26, 36, 255, 98, 130, 85, 255, 109, 146, 100, 255, 103, 140, 93, 255, 106, 147, 111, 255, 97, 141, 110, 255, 85, 127, 94, 255, 112, 168, 176, 255, 107, 166, 180, 255, 97, 158, 163, 255, 109, 172, 191, 255, 108, 172, 195, 255, 104, 167, 191, 255, 106, 166, 190, 255, 108, 166, 190, 255, 133, 177, 197, 255, 143, 178, 199, 255, 142, 177, 197, 255, 138, 174, 194, 255, 135, 171, 191, 255, 134, 169, 189, 255, 130, 166, 187, 255, 126, 164, 184, 255, 117, 153, 173, 255, 114, 148, 169, 255, 116, 147, 170, 255, 116, 146, 169, 255, 113, 145, 168, 255, 113, 144, 166, 255, 108, 138, 159, 255, 105, 136, 157, 255, 103, 135, 157, 255, 100, 134, 156, 255, 100, 134, 154, 255, 111, 143, 163, 255, 110, 140, 162, 255, 109, 140, 164, 255, 107, 141, 165, 255, 106, 139, 167, 255, 113, 145, 173, 255, 77, 108, 90, 255, 69, 97, 59, 255, 69, 95, 60, 255, 74, 102, 67, 255, 77, 105, 73, 255, 81, 107, 83, 255, 89, 118, 88, 255, 63, 91, 70, 255, 12, 22, 48, 255, 22, 34, 63, 255, 18, 29, 60, 255, 19, 30, 59, 255, 6, 20, 49, 255, 39, 64, 56, 255, 91, 129, 88, 255, 79, 111, 78, 255, 83, 112, 80, 255, 82, 112, 82, 255, 78, 114, 81, 255, 83, 120, 84, 255, 83, 120, 86, 255, 79, 114, 83, 255, 77, 109, 80, 255, 75, 106, 77, 255, 86, 122, 86, 255, 85, 120, 83, 255, 83, 116, 80, 255, 82, 113, 78, 255, 80, 112, 78, 255, 79, 110, 77, 255, 79, 112, 79, 255, 81, 112, 80, 255, 80, 110, 78, 255, 78, 107, 74, 255, 86, 119, 83, 255, 18, 33, 49, 255, 18, 32, 65, 255, 49, 61, 96, 255, 61, 72, 109, 255, 60, 68, 106, 255, 29, 41, 55, 255, 65, 91, 76, 255, 51, 70, 64, 255, 47, 67, 61, 255, 50, 78, 65, 255, 80, 123, 85, 255, 92, 136, 88, 255, 86, 125, 82, 255, 85, 127, 84, 255, 78, 119, 76, 255, 126, 178, 183, 255, 126, 186, 208, 255, 116, 175, 197, 255, 120, 179, 201, 255, 123, 179, 198, 255, 131, 175, 194, 255, 136, 176, 194, 255, 133, 174, 191, 255, 129, 171, 189, 255, 126, 169, 186, 255, 125, 164, 183, 255, 123, 164, 182, 255, 122, 164, 182, 255, 122, 161, 180, 255, 124, 158, 178, 255, 123, 156, 177, 255, 118, 150, 172, 255, 109, 143, 163, 255, 106, 139, 160, 255, 104, 137, 156, 255, 103, 138, 156, 255, 101, 137, 153, 255, 100, 137, 152, 255, 99, 137, 151, 255, 107, 135, 155, 255, 109, 141, 161, 255, 108, 142, 162, 255, 111, 142, 164, 255, 110, 142, 162, 255, 119, 145, 168, 255, 108, 135, 143, 255, 97, 126, 121, 255, 95, 120, 115, 255, 75, 104, 84, 255, 78, 105, 80, 255, 73, 99, 73, 255, 80, 111, 74, 255, 67, 98, 70, 255, 4, 15, 44, 255, 22, 37, 67, 255, 39, 52, 85, 255, 65, 81, 116, 255, 25, 43, 68, 255, 63, 100, 73, 255, 89, 127, 89, 255, 82, 113, 81, 255, 95, 125, 90, 255, 96, 126, 93, 255, 106, 139, 103, 255, 84, 113, 85, 255, 23, 37, 49, 255, 20, 32, 52, 255, 17, 24, 47, 255, 17, 27, 50, 255, 19, 35, 46, 255, 79, 115, 83, 255, 89, 126, 86, 255, 81, 113, 78, 255, 79, 112, 78, 255, 78, 112, 79, 255, 77, 115, 79, 255, 84, 115, 81, 255, 90, 118, 84, 255, 91, 119, 84, 255, 103, 133, 94, 255, 39, 54, 67, 255, 45, 59, 81, 255, 25, 33, 56, 255, 25, 32, 64, 255, 33, 40, 70, 255, 10, 23, 41, 255, 12, 28, 55, 255, 13, 27, 57, 255, 20, 34, 66, 255, 0, 9, 35, 255, 66, 101, 98, 255, 108, 159, 123, 255, 105, 156, 138, 255, 122, 179, 176, 255, 127, 183, 191, 255, 129, 194, 213, 255, 123, 188, 206, 255, 123, 184, 203, 255, 133, 181, 197, 255, 134, 179, 195, 255, 140, 181, 195, 255, 139, 183, 196, 255, 137, 180, 195, 255, 132, 174, 190, 255, 129, 170, 186, 255, 129, 169, 185, 255, 129, 168, 184, 255, 129, 167, 184, 255, 128, 164, 182, 255, 128, 162, 180, 255, 126, 163, 180, 255, 125, 162, 179, 255, 123, 158, 176, 255, 116, 154, 171, 255, 111, 149, 164, 255, 105, 145, 158, 255, 103, 145, 157, 255, 104, 143, 156, 255, 102, 141, 152, 255, 108, 137, 155, 255, 109, 138, 157, 255, 114, 143, 163, 255, 115, 144, 164, 255, 111, 139, 157, 255, 110, 139, 156, 255, 117, 145, 167, 255, 124, 153, 176, 255, 124, 156, 177, 255, 120, 153, 168, 255, 117, 144, 153, 255, 106, 131, 131, 255, 110, 137, 120, 255, 66, 92, 81, 255, 39, 55, 80, 255, 68, 83, 103, 255, 33, 44, 68, 255, 21, 30, 60, 255, 32, 52, 56, 255, 90, 133, 89, 255, 75, 113, 75, 255, 82, 112, 79, 255, 86, 116, 82, 255, 86, 119, 83, 255, 106, 137, 94, 255, 67, 87, 73, 255, 0, 4, 33, 255, 25, 32, 63, 255, 47, 51, 85, 255, 44, 56, 90, 255, 20, 36, 46, 255, 85, 125, 86, 255, 79, 119, 78, 255, 75, 110, 73, 255, 74, 110, 75, 255, 70, 109, 74, 255, 73, 108, 75, 255, 80, 110, 76, 255, 92, 118, 84, 255, 117, 146, 104, 255, 66, 85, 81, 255, 67, 80, 113, 255, 215, 235, 252, 255, 188, 204, 222, 255, 121, 135, 151, 255, 39, 55, 64, 255, 29, 48, 61, 255, 0, 23, 43, 255, 12, 32, 55, 255, 35, 52, 82, 255, 28, 45, 54, 255, 136, 191, 201, 255, 132, 193, 211, 255, 125, 195, 215, 255, 123, 197, 217, 255, 124, 192, 213, 255, 123, 190, 209, 255, 122, 186, 207, 255, 127, 184, 203, 255, 145, 187, 202, 255, 145, 188, 202, 255, 142, 185, 200, 255, 134, 180, 193, 255, 130, 174, 190, 255, 126, 172, 188, 255, 123, 171, 186, 255, 122, 169, 185, 255, 120, 167, 184, 255, 123, 167, 184, 255, 125, 167, 184, 255, 126, 168, 185, 255, 123, 169, 184, 255, 125, 168, 183, 255, 125, 167, 180, 255, 121, 164, 176, 255, 105, 148, 152, 255, 98, 142, 141, 255, 101, 145, 148, 255, 101, 144, 147, 255, 102, 143, 147, 255, 110, 137, 157, 255, 113, 136, 156, 255, 113, 138, 157, 255, 112, 139, 157, 255, 115, 139, 157, 255, 113, 140, 155, 255, 112, 139, 153, 255, 112, 140, 152, 255, 117, 147, 157, 255, 121, 150, 159, 255, 124, 151, 163, 255, 132, 160, 172, 255, 125, 150, 166, 255, 30, 44, 71, 255, 127, 152, 182, 255, 205, 230, 255, 255, 199, 220, 242, 255, 64, 77, 99, 255, 74, 104, 86, 255, 107, 144, 102, 255, 94, 134, 93, 255, 87, 126, 86, 255, 86, 123, 85, 255, 89, 127, 88, 255, 98, 127, 95, 255, 42, 56, 65, 255, 89, 98, 136, 255, 49, 55, 82, 255, 22, 32, 58, 255, 14, 25, 50, 255, 75, 104, 78, 255, 102, 140, 92, 255, 85, 122, 83, 255, 83, 119, 82, 255, 94, 131, 92, 255, 95, 134, 94, 255, 96, 128, 89, 255, 96, 131, 91, 255, 96, 130, 89, 255, 108, 139, 94, 255, 58, 72, 85, 255, 157, 170, 200, 255, 199, 220, 246, 255, 212, 235, 255, 255, 113, 138, 155, 255, 63, 92, 98, 255, 55, 76, 96, 255, 114, 138, 169, 255, 84, 106, 128, 255, 11, 25, 39, 255, 77, 121, 128, 255, 130, 199, 211, 255, 120, 177, 193, 255, 123, 183, 201, 255, 119, 185, 203, 255, 122, 186, 206, 255, 120, 187, 206, 255, 122, 186, 204, 255, 134, 189, 203, 255, 144, 190, 203, 255, 145, 189, 204, 255, 144, 189, 202, 255, 141, 185, 198, 255, 136, 179, 194, 255, 135, 176, 192, 255, 137, 174, 190, 255, 136, 174, 189, 255, 133, 171, 187, 255, 129, 169, 184, 255, 127, 168, 183, 255, 126, 167, 182, 255, 124, 166, 180, 255, 123, 166, 179, 255, 117, 163, 174, 255, 116, 159, 168, 255, 110, 156, 160, 255, 105, 151, 152, 255, 97, 141, 148, 255, 93, 138, 147, 255, 95, 142, 152, 255, 105, 138, 158, 255, 108, 139, 160, 255, 107, 134, 157, 255, 108, 133, 155, 255, 110, 136, 158, 255, 110, 141, 160, 255, 117, 145, 161, 255, 116, 146, 160, 255, 117, 148, 163, 255, 115, 148, 161, 255, 114, 147, 159, 255, 122, 159, 171, 255, 84, 115, 130, 255, 92, 109, 135, 255, 188, 214, 248, 255, 185, 210, 243, 255, 151, 174, 196, 255, 62, 88, 107, 255, 137, 184, 191, 255, 124, 168, 170, 255, 127, 169, 164, 255, 122, 168, 148, 255, 118, 167, 151, 255, 136, 185, 168, 255, 65, 88, 100, 255, 77, 87, 131, 255, 169, 175, 234, 255, 168, 177, 232, 255, 84, 99, 136, 255, 43, 61, 69, 255, 130, 165, 127, 255, 128, 161, 132, 255, 128, 159, 118, 255, 121, 155, 111, 255, 119, 155, 113, 255, 116, 152, 113, 255, 115, 153, 119, 255, 115, 156, 125, 255, 113, 149, 120, 255, 128, 164, 135, 255, 70, 92, 103, 255, 162, 176, 203, 255, 207, 230, 255, 255, 176, 195, 214, 255, 54, 78, 97, 255, 61, 89, 110, 255, 98, 118, 156, 255, 182, 202, 253, 255, 183, 201, 236, 255, 59, 82, 98, 255, 135, 189, 200, 255, 129, 189, 202, 255, 125, 182, 196, 255, 129, 177, 194, 255, 131, 179, 196, 255, 143, 188, 205, 255, 134, 184, 201, 255, 135, 185, 201, 255, 135, 181, 197, 255, 134, 177, 195, 255, 138, 180, 197, 255, 138, 180, 197, 255, 138, 176, 194, 255, 135, 174, 190, 255, 139, 176, 192, 255, 139, 176, 192, 255, 135, 175, 190, 255, 139, 175, 190, 255, 137, 175, 189, 255, 134, 174, 187, 255, 131, 174, 186, 255, 132, 174, 188, 255, 131, 172, 186, 255, 132, 173, 187, 255, 135, 175, 188, 255, 137, 179, 192, 255, 134, 176, 189, 255, 122, 166, 180, 255, 105, 150, 166, 255, 96, 143, 161, 255, 88, 135, 161, 255, 90, 136, 163, 255, 84, 129, 157, 255, 84, 129, 157, 255, 83, 130, 158, 255, 88, 135, 163, 255, 94, 142, 167, 255, 99, 148, 169, 255, 98, 149, 170, 255, 107, 153, 175, 255, 106, 155, 172, 255, 126, 171, 187, 255, 101, 135, 151, 255, 82, 98, 124, 255, 189, 214, 245, 255, 203, 227, 255, 255, 88, 109, 131, 255, 41, 67, 90, 255, 98, 135, 154, 255, 123, 177, 194, 255, 123, 168, 187, 255, 127, 177, 194, 255, 121, 181, 198, 255, 135, 191, 205, 255, 50, 77, 96, 255, 128, 147, 197, 255, 151, 166, 222, 255, 147, 163, 215, 255, 57, 79, 102, 255, 117, 174, 181, 255, 129, 190, 191, 255, 123, 184, 191, 255, 124, 181, 178, 255, 115, 173, 168, 255, 123, 180, 178, 255, 134, 181, 183, 255, 133, 186, 192, 255, 133, 191, 202, 255, 137, 192, 204, 255, 162, 217, 231, 255, 79, 115, 132, 255, 128, 150, 173, 255, 216, 245, 255, 255, 119, 140, 161, 255, 88, 112, 135, 255, 78, 102, 127, 255, 133, 146, 192, 255, 179, 192, 244, 255, 101, 113, 148, 255, 99, 117, 134, 255, 194, 219, 234, 255, 166, 200, 214, 255, 164, 204, 217, 255, 162, 203, 216, 255, 161, 203, 215, 255, 162, 204, 216, 255, 160, 202, 215, 255, 159, 199, 214, 255, 157, 197, 212, 255, 159, 193, 209, 255, 163, 193, 211, 255, 159, 190, 207, 255, 151, 182, 198, 255, 147, 181, 196, 255, 142, 178, 193, 255, 140, 179, 193, 255, 140, 182, 196, 255, 135, 181, 195, 255, 133, 181, 193, 255, 129, 179, 192, 255, 129, 179, 192, 255, 127, 176, 190, 255, 126, 179, 191, 255, 121, 178, 189, 255, 123, 177, 188, 255, 120, 175, 185, 255, 125, 177, 188, 255, 126, 179, 188, 255, 117, 173, 181, 255, 106, 162, 174, 255, 85, 134, 162, 255, 80, 134, 160, 255, 81, 137, 161, 255, 82, 139, 162, 255, 83, 140, 163, 255, 83, 137, 163, 255, 86, 141, 167, 255, 87, 148, 172, 255, 88, 149, 174, 255, 91, 151, 176, 255, 96, 156, 178, 255, 102, 164, 185, 255, 91, 147, 163, 255, 51, 74, 96, 255, 189, 219, 249, 255, 165, 188, 214, 255, 23, 38, 60, 255, 6, 19, 47, 255, 3, 18, 42, 255, 75, 115, 134, 255, 124, 180, 201, 255, 115, 163, 185, 255, 122, 176, 193, 255, 124, 177, 191, 255, 46, 71, 93, 255, 134, 154, 205, 255, 157, 177, 233, 255, 86, 106, 144, 255, 70, 118, 124, 255, 128, 213, 217, 255, 109, 191, 199, 255, 113, 193, 200, 255, 115, 192, 200, 255, 114, 189, 198, 255, 118, 188, 199, 255, 122, 184, 197, 255, 120, 186, 195, 255, 124, 189, 199, 255, 123, 189, 199, 255, 142, 213, 220, 255, 75, 121, 137, 255, 122, 152, 172, 255, 212, 248, 255, 255, 96, 119, 139, 255, 117, 150, 171, 255, 87, 113, 136, 255, 124, 134, 180, 255, 181, 190, 244, 255, 76, 89, 122, 255, 155, 178, 190, 255, 193, 219, 232, 255, 182, 213, 225, 255, 184, 214, 226, 255, 183, 216, 226, 255, 183, 219, 228, 255, 180, 217, 226, 255, 184, 215, 226, 255, 182, 214, 224, 255, 180, 214, 224, 255, 185, 214, 226, 255, 188, 215, 227, 255, 190, 214, 227, 255, 185, 211, 226, 255, 175, 207, 222, 255, 173, 205, 218, 255, 165, 202, 214, 255, 158, 197, 209, 255, 140, 185, 196, 255, 144, 188, 198, 255, 146, 190, 199, 255, 142, 186, 196, 255, 143, 188, 197, 255, 145, 189, 199, 255, 141, 188, 198, 255, 135, 184, 195, 255, 123, 175, 189, 255, 111, 169, 182, 255, 111, 170, 183, 255, 112, 171, 184, 255, 110, 170, 181, 255, 79, 123, 156, 255, 78, 129, 159, 255, 76, 131, 159, 255, 71, 127, 155, 255, 72, 128, 154, 255, 75, 131, 156, 255, 75, 132, 158, 255, 80, 138, 164, 255, 80, 143, 167, 255, 81, 145, 168, 255, 80, 145, 168, 255, 84, 151, 173, 255, 77, 140, 158, 255, 59, 85, 108, 255, 187, 216, 242, 255, 39, 57, 77, 255, 7, 24, 52, 255, 58, 79, 123, 255, 28, 53, 88, 255, 0, 16, 39, 255, 80, 123, 139, 255, 123, 191, 206, 255, 118, 185, 200, 255, 109, 162, 174, 255, 54, 72, 100, 255, 149, 172, 225, 255, 147, 171, 225, 255, 57, 82, 106, 255, 105, 177, 180, 255, 107, 197, 202, 255, 98, 192, 194, 255, 103, 191, 198, 255, 108, 189, 198, 255, 110, 193, 199, 255, 111, 188, 198, 255, 118, 186, 199, 255, 118, 190, 201, 255, 120, 188, 199, 255, 118, 187, 197, 255, 133, 208, 219, 255, 79, 126, 142, 255, 117, 152, 171, 255, 209, 248, 255, 255, 88, 111, 132, 255, 122, 168, 184, 255, 84, 120, 139, 255, 121, 131, 177, 255, 173, 187, 239, 255, 76, 95, 124, 255, 136, 183, 194, 255, 152, 203, 213, 255, 161, 207, 217, 255, 168, 209, 221, 255, 170, 211, 222, 255, 169, 212, 222, 255, 177, 217, 228, 255, 178, 218, 230, 255, 168, 213, 225, 255, 159, 204, 218, 255, 150, 196, 212, 255, 150, 197, 212, 255, 157, 200, 214, 255, 158, 200, 214, 255, 157, 201, 216, 255, 142, 192, 206, 255, 141, 190, 206, 255, 139, 189, 206, 255, 141, 192, 208, 255, 152, 198, 212, 255, 145, 196, 208, 255, 146, 194, 205, 255, 139, 189, 198, 255, 141, 189, 198, 255, 137, 183, 192, 255, 114, 162, 174, 255, 110, 161, 174, 255, 109, 161, 173, 255, 103, 158, 170, 255, 94, 152, 166, 255, 87, 148, 164, 255, 107, 143, 150, 255, 104, 142, 149, 255, 102, 145, 152, 255, 110, 154, 161, 255, 121, 168, 171, 255, 101, 157, 168, 255, 104, 160, 169, 255, 92, 152, 166, 255, 92, 157, 171, 255, 96, 165, 176, 255, 94, 162, 176, 255, 93, 161, 175, 255, 87, 147, 157, 255, 75, 101, 124, 255, 121, 148, 169, 255, 10, 26, 49, 255, 84, 103, 145, 255, 53, 77, 124, 255, 49, 80, 122, 255, 27, 53, 86, 255, 0, 17, 39, 255, 78, 125, 137, 255, 136, 209, 206, 255, 79, 126, 133, 255, 79, 87, 126, 255, 168, 184, 240, 255, 125, 144, 192, 255, 62, 92, 101, 255, 148, 208, 187, 255, 125, 196, 180, 255, 121, 193, 173, 255, 116, 188, 171, 255, 116, 186, 176, 255, 115, 187, 187, 255, 120, 188, 193, 255, 124, 188, 194, 255, 115, 184, 190, 255, 125, 187, 194, 255, 132, 189, 195, 255, 160, 209, 219, 255, 101, 135, 152, 255, 117, 148, 169, 255, 211, 244, 255, 255, 83, 106, 128, 255, 141, 181, 169, 255, 90, 123, 129, 255, 117, 134, 179, 255, 168, 185, 234, 255, 76, 101, 122, 255, 142, 194, 190, 255, 138, 195, 185, 255, 140, 195, 188, 255, 136, 192, 186, 255, 131, 191, 185, 255, 137, 193, 189, 255, 146, 200, 194, 255, 144, 197, 191, 255, 142, 196, 184, 255, 144, 198, 189, 255, 135, 196, 189, 255, 134, 194, 185, 255, 121, 181, 163, 255, 124, 180, 158, 255, 142, 195, 185, 255, 154, 207, 209, 255, 141, 200, 201, 255, 141, 198, 198, 255, 138, 197, 192, 255, 137, 192, 184, 255, 142, 196, 192, 255, 147, 195, 196, 255, 152, 198, 199, 255, 162, 205, 207, 255, 177, 214, 214, 255, 180, 216, 211, 255, 179, 215, 208, 255, 189, 220, 212, 255, 192, 220, 212, 255, 180, 211, 205, 255, 160, 198, 190, 255, 165, 179, 135, 255, 167, 179, 135, 255, 172, 184, 140, 255, 171, 187, 138, 255, 157, 181, 131, 255, 143, 177, 130, 255, 142, 177, 133, 255, 135, 176, 140, 255, 129, 175, 143, 255, 130, 177, 144, 255, 130, 178, 152, 255, 128, 184, 163, 255, 121, 169, 150, 255, 86, 105, 128, 255, 105, 127, 152, 255, 27, 45, 74, 255, 142, 163, 206, 255, 83, 105, 147, 255, 46, 69, 114, 255, 51, 78, 122, 255, 27, 53, 87, 255, 0, 19, 41, 255, 115, 155, 137, 255, 81, 113, 111, 255, 105, 111, 160, 255, 181, 187, 245, 255, 99, 106, 152, 255, 101, 124, 112, 255, 179, 215, 171, 255, 158, 196, 152, 255, 157, 193, 143, 255, 150, 187, 134, 255, 144, 183, 131, 255, 137, 179, 131, 255, 150, 186, 140, 255, 149, 189, 147, 255, 136, 180, 136, 255, 141, 184, 147, 255, 151, 190, 164, 255, 173, 207, 188, 255, 111, 137, 143, 255, 123, 142, 169, 255, 212, 238, 255, 255, 81, 102, 127, 255, 159, 190, 152, 255, 101, 127, 118, 255, 115, 135, 182, 255, 160, 179, 229, 255, 83, 103, 115, 255, 172, 203, 153, 255, 164, 195, 145, 255, 161, 192, 145, 255, 159, 193, 144, 255, 160, 194, 146, 255, 160, 193, 146, 255, 157, 191, 142, 255, 158, 190, 140, 255, 162, 191, 141, 255, 161, 192, 142, 255, 159, 193, 140, 255, 164, 195, 140, 255, 166, 194, 142, 255, 166, 193, 143, 255, 164, 192, 140, 255, 167, 195, 146, 255, 165, 192, 143, 255, 162, 190, 140, 255, 163, 189, 139, 255, 160, 189, 138, 255, 164, 192, 142, 255, 164, 193, 143, 255, 167, 194, 145, 255, 171, 194, 145, 255, 171, 192, 143, 255, 170, 191, 141, 255, 167, 189, 138, 255, 167, 189, 140, 255, 168, 188, 140, 255, 169, 191, 142, 255, 170, 191, 143, 255, 171, 183, 135, 255, 178, 187, 141, 255, 183, 188, 144, 255, 176, 181, 135, 255, 160, 172, 119, 255, 157, 174, 120, 255, 156, 174, 121, 255, 153, 174, 120, 255, 151, 177, 123, 255, 151, 178, 123, 255, 150, 176, 122, 255, 156, 181, 128, 255, 153, 174, 129, 255, 83, 93, 118, 255, 181, 201, 232, 255, 41, 53, 78, 255, 63, 79, 117, 255, 141, 161, 203, 255, 92, 108, 154, 255, 52, 70, 118, 255, 52, 79, 123, 255, 24, 54, 88, 255, 5, 29, 48, 255, 39, 64, 80, 255, 145, 161, 211, 255, 167, 177, 234, 255, 74, 80, 117, 255, 146, 164, 127, 255, 175, 207, 154, 255, 161, 196, 145, 255, 167, 195, 146, 255, 168, 194, 147, 255, 169, 195, 147, 255, 169, 194, 144, 255, 167, 193, 143, 255, 163, 193, 140, 255, 164, 196, 143, 255, 164, 197, 145, 255, 167, 196, 150, 255, 183, 209, 159, 255, 121, 138, 120, 255, 124, 138, 171, 255, 214, 234, 255, 255, 84, 100, 124, 255, 169, 195, 151, 255, 108, 130, 119, 255, 117, 134, 182, 255, 155, 174, 226, 255, 87, 108, 112, 255, 176, 207, 150, 255, 167, 196, 147, 255, 169, 196, 147, 255, 167, 194, 145, 255, 166, 192, 144, 255, 169, 193, 147, 255, 175, 196, 152, 255, 175, 198, 152, 255, 174, 197, 152, 255, 173, 195, 149, 255, 173, 194, 147, 255, 173, 194, 145, 255, 176, 197, 149, 255, 176, 198, 149, 255, 174, 196, 147, 255, 174, 196, 146, 255, 172, 195, 144, 255, 171, 196, 144, 255, 171, 194, 143, 255, 173, 194, 144, 255, 171, 194, 142, 255, 171, 194, 141, 255, 172, 193, 141, 255, 170, 190, 139, 255, 171, 190, 139, 255, 172, 189, 139, 255, 171, 188, 139, 255, 171, 188, 140, 255, 169, 188, 139, 255, 167, 187, 138, 255, 166, 188, 139, 255, 172, 184, 134, 255, 175, 184, 136, 255, 177, 185, 138, 255, 181, 187, 140, 255, 165, 176, 124, 255, 158, 173, 120, 255, 156, 174, 121, 255, 155, 178, 122, 255, 152, 177, 123, 255, 153, 178, 123, 255, 152, 179, 124, 255, 157, 183, 128, 255, 149, 174, 121, 255, 76, 90, 113, 255, 200, 222, 255, 255, 139, 154, 180, 255, 3, 18, 39, 255, 59, 77, 116, 255, 141, 160, 202, 255, 98, 112, 161, 255, 51, 70, 119, 255, 51, 80, 125, 255, 26, 56, 90, 255, 1, 26, 47, 255, 115, 133, 172, 255, 156, 171, 228, 255, 62, 72, 92, 255, 175, 196, 146, 255, 168, 200, 148, 255, 166, 197, 147, 255, 167, 197, 148, 255, 166, 197, 146, 255, 167, 198, 146, 255, 169, 197, 146, 255, 168, 195, 144, 255, 169, 197, 146, 255, 170, 196, 144, 255, 166, 196, 143, 255, 166, 194, 143, 255, 183, 212, 156, 255, 123, 144, 123, 255, 113, 128, 166, 255, 189, 206, 248, 255, 90, 103, 130, 255, 179, 200, 153, 255, 118, 136, 124, 255, 118, 131, 183, 255, 151, 168, 221, 255, 90, 111, 112, 255, 181, 211, 156, 255, 168, 198, 150, 255, 170, 199, 150, 255, 172, 197, 151, 255, 174, 198, 152, 255, 176, 199, 155, 255, 180, 199, 156, 255, 179, 199, 155, 255, 180, 199, 155, 255, 180, 198, 154, 255, 179, 197, 152, 255, 175, 195, 148, 255, 176, 196, 148, 255, 177, 197, 149, 255, 178, 197, 151, 255, 177, 198, 150, 255, 175, 197, 148, 255, 174, 196, 147, 255, 172, 196, 146, 255, 174, 196, 145, 255, 174, 195, 144, 255, 174, 194, 144, 255, 174, 193, 143, 255, 174, 193, 142, 255, 172, 191, 140, 255, 171, 189, 137, 255, 172, 187, 136, 255, 171, 186, 136, 255, 170, 186, 137, 255, 168, 187, 136, 255, 166, 187, 136, 255, 169, 181, 130, 255, 172, 183, 133, 255, 176, 185, 137, 255, 182, 188, 141, 255, 178, 184, 135, 255, 162, 174, 122, 255, 157, 173, 119, 255, 158, 176, 121, 255, 157, 179, 123, 255, 159, 181, 125, 255, 154, 182, 125, 255, 162, 189, 133, 255, 151, 178, 128, 255, 79, 96, 120, 255, 195, 217, 255, 255, 124, 139, 174, 255, 120, 144, 111, 255, 55, 70, 74, 255, 45, 60, 104, 255, 140, 158, 201, 255, 96, 110, 159, 255, 53, 72, 122, 255, 54, 80, 126, 255, 27, 53, 87, 255, 14, 34, 55, 255, 72, 93, 130, 255, 82, 105, 104, 255, 183, 211, 155, 255, 169, 197, 147, 255, 173, 196, 148, 255, 169, 198, 147, 255, 168, 197, 147, 255, 175, 200, 152, 255, 179, 202, 156, 255, 182, 202, 155, 255, 176, 197, 146, 255, 173, 196, 143, 255, 172, 196, 143, 255, 171, 195, 143, 255, 190, 213, 160, 255, 80, 102, 106, 255, 115, 135, 181, 255, 156, 175, 226, 255, 76, 91, 127, 255, 177, 191, 151, 255, 129, 141, 128, 255, 111, 122, 179, 255, 136, 155, 211, 255, 90, 110, 114, 255, 188, 213, 160, 255, 175, 199, 153, 255, 175, 201, 154, 255, 176, 201, 155, 255, 179, 201, 157, 255, 179, 201, 156, 255, 179, 200, 156, 255, 180, 200, 156, 255, 180, 200, 156, 255, 181, 199, 156, 255, 181, 199, 155, 255, 179, 198, 152, 255, 179, 199, 153, 255, 180, 200, 153, 255, 180, 198, 151, 255, 182, 199, 153, 255, 181, 200, 152, 255, 180, 200, 152, 255, 177, 198, 149, 255, 176, 197, 146, 255, 178, 195, 146, 255, 177, 194, 144, 255, 176, 192, 142, 255, 174, 191, 139, 255, 172, 189, 137, 255, 171, 189, 136, 255, 171, 187, 136, 255, 171, 187, 135, 255, 172, 187, 134, 255, 172, 187, 134, 255, 171, 187, 134, 255, 160, 175, 121, 255, 168, 179, 129, 255, 174, 184, 135, 255, 178, 188, 139, 255, 179, 188, 140, 255, 176, 186, 137, 255, 160, 175, 121, 255, 158, 176, 120, 255, 162, 179, 125, 255, 162, 181, 126, 255, 160, 183, 128, 255, 174, 197, 144, 255, 160, 183, 137, 255, 77, 98, 123, 255, 181, 207, 250, 255, 115, 136, 175, 255, 129, 153, 116, 255, 190, 217, 156, 255, 50, 67, 72, 255, 38, 56, 97, 255, 142, 163, 205, 255, 96, 112, 159, 255, 52, 67, 117, 255, 57, 76, 125, 255, 35, 57, 91, 255, 0, 13, 39, 255, 101, 128, 113, 255, 195, 228, 167, 255, 170, 194, 147, 255, 173, 197, 148, 255, 168, 199, 147, 255, 172, 201, 151, 255, 189, 204, 160, 255, 196, 205, 165, 255, 197, 208, 168, 255, 192, 205, 162, 255, 180, 196, 146, 255, 173, 192, 140, 255, 192, 212, 156, 255, 137, 153, 126, 255, 80, 104, 135, 255, 148, 176, 220, 255, 138, 161, 213, 255, 91, 107, 152, 255, 129, 143, 127, 255, 114, 127, 124, 255, 104, 120, 182, 255, 129, 148, 208, 255, 63, 84, 108, 255, 176, 196, 151, 255, 181, 204, 156, 255, 178, 201, 155, 255, 178, 201, 156, 255, 180, 199, 156, 255, 181, 199, 156, 255, 182, 200, 157, 255, 181, 199, 156, 255, 178, 199, 155, 255, 177, 200, 154, 255, 182, 204, 161, 255, 181, 203, 159, 255, 182, 203, 159, 255, 179, 203, 155, 255, 178, 200, 152, 255, 183, 200, 154, 255, 184, 199, 153, 255, 182, 200, 152, 255, 183, 200, 152, 255, 181, 197, 147, 255, 180, 192, 140, 255, 179, 192, 141, 255, 177, 192, 140, 255, 174, 189, 137, 255, 172, 189, 137, 255, 172, 191, 136, 255, 171, 188, 133, 255, 172, 187, 133, 255, 171, 187, 132, 255, 172, 185, 132, 255, 173, 185, 133, 255, 156, 170, 117, 255, 160, 171, 120, 255, 170, 180, 130, 255, 177, 189, 139, 255, 180, 191, 143, 255, 184, 193, 145, 255, 171, 183, 133, 255, 160, 178, 124, 255, 161, 179, 124, 255, 163, 179, 126, 255, 174, 188, 139, 255, 190, 206, 156, 255, 153, 172, 136, 255, 63, 87, 122, 255, 167, 195, 240, 255, 98, 123, 170, 255, 116, 137, 116, 255, 184, 208, 148, 255, 179, 207, 151, 255, 57, 77, 76, 255, 33, 52, 91, 255, 141, 162, 205, 255, 98, 111, 159, 255, 53, 70, 119, 255, 56, 78, 126, 255, 36, 58, 95, 255, 19, 37, 56, 255, 152, 174, 140, 255, 197, 222, 169, 255, 173, 197, 149, 255, 171, 198, 149, 255, 189, 207, 162, 255, 200, 209, 169, 255, 198, 207, 167, 255, 199, 208, 169, 255, 199, 207, 169, 255, 185, 200, 152, 255, 175, 194, 142, 255, 192, 209, 157, 255, 86, 99, 104, 255, 145, 167, 202, 255, 162, 188, 226, 255, 165, 188, 228, 255, 157, 177, 213, 255, 71, 85, 96, 255, 81, 96, 130, 255, 130, 151, 209, 255, 149, 168, 221, 255, 83, 101, 144, 255, 121, 141, 119, 255, 195, 215, 164, 255, 179, 198, 155, 255, 179, 202, 156, 255, 180, 200, 155, 255, 181, 200, 157, 255, 180, 201, 157, 255, 181, 199, 156, 255, 179, 198, 154, 255, 182, 203, 160, 255, 188, 208, 170, 255, 185, 204, 164, 255, 186, 206, 164, 255, 183, 204, 160, 255, 181, 202, 157, 255, 183, 201, 155, 255, 184, 200, 153, 255, 183, 199, 151, 255, 185, 200, 152, 255, 181, 196, 144, 255, 179, 189, 135, 255, 177, 187, 134, 255, 177, 189, 136, 255, 176, 189, 136, 255, 174, 189, 136, 255, 175, 190, 136, 255, 175, 190, 135, 255, 175, 188, 133, 255, 175, 186, 132, 255, 179, 189, 138, 255, 182, 193, 144, 255, 153, 169, 115, 255, 160, 173, 122, 255, 170, 182, 133, 255, 178, 189, 141, 255, 181, 192, 144, 255, 180, 191, 144, 255, 178, 189, 140, 255, 180, 191, 143, 255, 171, 184, 134, 255, 173, 187, 138, 255, 186, 196, 153, 255, 201, 213, 163, 255, 110, 129, 117, 255, 72, 96, 141, 255, 155, 182, 232, 255, 116, 142, 191, 255, 76, 98, 106, 255, 179, 200, 145, 255, 172, 194, 143, 255, 184, 212, 156, 255, 67, 86, 80, 255, 37, 55, 89, 255, 144, 163, 206, 255, 101, 119, 166, 255, 49, 71, 120, 255, 57, 77, 127, 255, 31, 50, 89, 255, 15, 30, 52, 255, 157, 179, 148, 255, 201, 228, 177, 255, 181, 202, 156, 255, 201, 208, 166, 255, 204, 208, 169, 255, 202, 207, 168, 255, 202, 207, 170, 255, 199, 206, 164, 255, 194, 207, 162, 255, 184, 203, 154, 255, 189, 205, 155, 255, 85, 97, 110, 255, 181, 202, 228, 255, 179, 206, 232, 255, 171, 198, 229, 255, 191, 218, 241, 255, 51, 66, 88, 255, 95, 112, 160, 255, 162, 182, 228, 255, 160, 179, 226, 255, 139, 158, 197, 255, 89, 104, 104, 255, 195, 214, 163, 255, 181, 199, 156, 255, 181, 201, 156, 255, 180, 201, 155, 255, 181, 201, 156, 255, 179, 201, 155, 255, 181, 200, 155, 255, 184, 202, 160, 255, 188, 208, 170, 255, 188, 208, 171, 255, 186, 206, 167, 255, 187, 207, 166, 255, 184, 203, 161, 255, 182, 201, 158, 255, 183, 202, 156, 255, 185, 201, 153, 255, 184, 198, 151, 255, 186, 198, 149, 255, 182, 194, 142, 255, 178, 187, 133, 255, 177, 185, 131, 255, 178, 184, 131, 255, 178, 185, 132, 255, 179, 187, 134, 255, 179, 188, 135, 255, 181, 189, 136, 255, 181, 189, 136, 255, 181, 189, 138, 255, 184, 195, 145, 255, 185, 195, 148, 255, 157, 172, 120, 255, 165, 181, 130, 255, 176, 189, 142, 255, 181, 191, 146, 255, 182, 194, 149, 255, 180, 193, 148, 255, 176, 190, 142, 255, 185, 195, 149, 255, 183, 195, 149, 255, 185, 196, 151, 255, 188, 197, 153, 255, 201, 208, 163, 255, 66, 82, 98, 255, 122, 145, 191, 255, 158, 184, 232, 255, 154, 180, 227, 255, 73, 97, 117, 255, 169, 191, 140, 255, 172, 194, 146, 255, 184, 210, 153, 255, 140, 163, 137, 255, 10, 25, 56, 255, 40, 55, 84, 255, 139, 158, 201, 255, 104, 124, 171, 255, 52, 69, 121, 255, 60, 78, 128, 255, 33, 51, 91, 255, 13, 28, 49, 255, 163, 183, 153, 255, 218, 235, 189, 255, 198, 207, 164, 255, 203, 206, 165, 255, 204, 208, 167, 255, 203, 207, 168, 255, 200, 205, 163, 255, 197, 207, 163, 255, 190, 205, 161, 255, 211, 223, 173, 255, 106, 115, 110, 255, 129, 148, 173, 255, 202, 229, 253, 255, 188, 216, 242, 255, 168, 194, 215, 255, 27, 42, 69, 255, 105, 126, 168, 255, 169, 187, 232, 255, 160, 179, 226, 255, 153, 170, 206, 255, 89, 101, 107, 255, 194, 213, 162, 255, 178, 200, 154, 255, 180, 201, 155, 255, 181, 202, 155, 255, 181, 202, 155, 255, 179, 201, 154, 255, 182, 201, 156, 255, 191, 209, 170, 255, 191, 209, 173, 255, 189, 207, 171, 255, 187, 207, 168, 255, 186, 205, 166, 255, 186, 203, 162, 255, 186, 201, 161, 255, 184, 201, 157, 255, 186, 202, 155, 255, 187, 200, 153, 255, 186, 198, 150, 255, 185, 194, 143, 255, 183, 190, 138, 255, 179, 186, 133, 255, 176, 183, 130, 255, 177, 186, 131, 255, 178, 188, 133, 255, 179, 189, 134, 255, 181, 189, 135, 255, 186, 192, 142, 255, 185, 192, 142, 255, 185, 195, 146, 255, 187, 196, 148, 255, 170, 182, 134, 255, 174, 188, 141, 255, 178, 191, 146, 255, 184, 196, 153, 255, 185, 197, 155, 255, 187, 199, 159, 255, 180, 194, 149, 255, 184, 193, 149, 255, 184, 197, 152, 255, 183, 197, 153, 255, 194, 203, 157, 255, 174, 183, 153, 255, 53, 67, 103, 255, 161, 184, 226, 255, 161, 183, 228, 255, 161, 182, 229, 255, 73, 96, 119, 255, 172, 197, 145, 255, 178, 200, 152, 255, 180, 200, 152, 255, 66, 80, 96, 255, 129, 145, 196, 255, 73, 90, 122, 255, 26, 42, 68, 255, 138, 159, 201, 255, 108, 127, 175, 255, 50, 68, 120, 255, 56, 80, 129, 255, 32, 54, 92, 255, 13, 30, 52, 255, 170, 183, 158, 255, 220, 230, 182, 255, 201, 205, 164, 255, 205, 209, 166, 255, 201, 204, 160, 255, 200, 203, 156, 255, 195, 204, 155, 255, 188, 200, 151, 255, 211, 220, 171, 255, 142, 152, 130, 255, 91, 111, 142, 255, 181, 206, 246, 255, 183, 209, 246, 255, 106, 131, 160, 255, 87, 102, 99, 255, 89, 107, 142, 255, 156, 176, 226, 255, 173, 191, 235, 255, 118, 135, 178, 255, 120, 131, 119, 255, 198, 218, 164, 255, 177, 198, 150, 255, 183, 202, 155, 255, 184, 204, 157, 255, 182, 202, 156, 255, 183, 203, 158, 255, 189, 209, 168, 255, 192, 211, 173, 255, 193, 210, 174, 255, 192, 209, 174, 255, 189, 208, 170, 255, 187, 204, 166, 255, 187, 202, 162, 255, 185, 200, 159, 255, 187, 202, 160, 255, 190, 204, 160, 255, 191, 202, 157, 255, 190, 201, 154, 255, 188, 197, 148, 255, 185, 194, 143, 255, 183, 191, 138, 255, 181, 188, 134, 255, 178, 186, 131, 255, 181, 190, 137, 255, 183, 192, 140, 255, 183, 191, 139, 255, 187, 194, 143, 255, 186, 193, 144, 255, 187, 195, 147, 255, 187, 195, 149, 255, 182, 190, 147, 255, 183, 193, 152, 255, 185, 197, 157, 255, 183, 197, 156, 255, 185, 198, 161, 255, 188, 201, 165, 255, 186, 199, 162, 255, 195, 202, 166, 255, 195, 203, 165, 255, 186, 197, 157, 255, 207, 215, 170, 255, 119, 126, 123, 255, 98, 114, 156, 255, 188, 207, 246, 255, 163, 180, 225, 255, 175, 195, 238, 255, 71, 92, 122, 255, 131, 156, 127, 255, 198, 222, 168, 255, 170, 187, 149, 255, 50, 63, 93, 255, 139, 152, 209, 255, 151, 167, 234, 255, 65, 80, 120, 255, 24, 41, 68, 255, 134, 156, 199, 255, 110, 131, 179, 255, 49, 71, 122, 255, 56, 79, 128, 255, 33, 56, 95, 255, 15, 30, 51, 255, 173, 183, 155, 255, 221, 229, 180, 255, 200, 206, 161, 255, 203, 204, 157, 255, 199, 200, 149, 255, 194, 200, 148, 255, 190, 196, 145, 255, 217, 224, 175, 255, 111, 124, 112, 255, 111, 130, 164, 255, 177, 199, 242, 255, 175, 199, 243, 255, 90, 114, 148, 255, 153, 167, 133, 255, 97, 112, 132, 255, 123, 141, 205, 255, 167, 184, 237, 255, 89, 106, 149, 255, 155, 168, 134, 255, 193, 213, 160, 255, 185, 203, 159, 255, 193, 208, 168, 255, 191, 209, 169, 255, 189, 208, 167, 255, 190, 208, 170, 255, 193, 213, 177, 255, 192, 211, 174, 255, 193, 212, 174, 255, 192, 210, 173, 255, 190, 207, 170, 255, 188, 206, 168, 255, 186, 203, 162, 255, 185, 202, 160, 255, 190, 202, 161, 255, 194, 205, 163, 255, 193, 205, 161, 255, 193, 204, 158, 255, 191, 200, 154, 255, 188, 197, 149, 255, 188, 196, 147, 255, 189, 196, 148, 255, 187, 194, 146, 255, 189, 197, 150, 255, 190, 198, 151, 255, 187, 196, 147, 255, 184, 193, 143, 255, 186, 193, 144, 255, 189, 196, 148, 255, 189, 196, 149, 255, 191, 194, 156, 255, 189, 195, 158, 255, 188, 196, 159, 255, 194, 202, 169, 255, 194, 204, 172, 255, 192, 201, 170, 255, 188, 201, 168, 255, 195, 202, 171, 255, 198, 206, 173, 255, 198, 204, 174, 255, 216, 222, 186, 255, 146, 155, 144, 255, 68, 84, 122, 255, 157, 173, 213, 255, 147, 161, 209, 255, 175, 195, 239, 255, 157, 179, 213, 255, 41, 58, 80, 255, 175, 194, 165, 255, 220, 236, 191, 255, 110, 124, 123, 255, 64, 77, 132, 255, 121, 132, 211, 255, 126, 138, 215, 255, 67, 81, 127, 255, 21, 39, 66, 255, 128, 150, 194, 255, 112, 133, 181, 255, 54, 71, 123, 255, 60, 79, 131, 255, 38, 57, 100, 255, 15, 29, 51, 255, 170, 180, 151, 255, 223, 232, 181, 255, 197, 204, 154, 255, 202, 207, 156, 255, 192, 201, 148, 255, 197, 203, 155, 255, 219, 224, 176, 255, 76, 90, 95, 255, 141, 160, 197, 255, 169, 188, 236, 255, 178, 200, 244, 255, 104, 123, 161, 255, 140, 148, 125, 255, 98, 112, 131, 255, 107, 120, 197, 255, 156, 172, 231, 255, 87, 103, 144, 255, 156, 170, 134, 255, 204, 221, 174, 255, 196, 212, 174, 255, 197, 213, 176, 255, 196, 213, 177, 255, 196, 211, 177, 255, 197, 213, 179, 255, 194, 214, 178, 255, 194, 211, 176, 255, 194, 210, 173, 255, 192, 208, 171, 255, 192, 206, 170, 255, 190, 204, 166, 255, 189, 202, 162, 255, 189, 202, 160, 255, 193, 202, 161, 255, 194, 201, 160, 255, 192, 202, 159, 255, 192, 203, 159, 255, 194, 202, 158, 255, 193, 201, 156, 255, 192, 200, 155, 255, 193, 200, 156, 255, 192, 199, 154, 255, 191, 198, 153, 255, 193, 198, 153, 255, 190, 198, 151, 255, 189, 197, 150, 255, 187, 196, 147, 255, 188, 194, 146, 255, 189, 194, 146, 255, 197, 196, 162, 255, 196, 196, 161, 255, 194, 197, 164, 255, 199, 203, 173, 255, 202, 209, 181, 255, 215, 220, 190, 255, 214, 223, 189, 255, 194, 207, 176, 255, 200, 211, 185, 255, 207, 213, 187, 255, 203, 209, 183, 255, 220, 228, 194, 255, 108, 119, 124, 255, 71, 86, 124, 255, 150, 167, 213, 255, 167, 185, 228, 255, 184, 203, 245, 255, 123, 140, 172, 255, 52, 65, 84, 255, 226, 235, 208, 255, 206, 217, 190, 255, 58, 74, 113, 255, 114, 127, 204, 255, 110, 119, 198, 255, 128, 138, 219, 255, 71, 84, 137, 255, 19, 39, 66, 255, 127, 148, 191, 255, 117, 136, 185, 255, 57, 72, 125, 255, 62, 77, 130, 255, 41, 59, 104, 255, 13, 28, 49, 255, 171, 179, 149, 255, 222, 231, 176, 255, 197, 206, 154, 255, 202, 209, 159, 255, 211, 218, 169, 255, 196, 205, 162, 255, 59, 76, 93, 255, 166, 187, 227, 255, 162, 182, 229, 255, 179, 199, 245, 255, 118, 135, 174, 255, 114, 121, 110, 255, 92, 102, 131, 255, 110, 121, 202, 255, 144, 157, 224, 255, 90, 107, 151, 255, 142, 156, 128, 255, 212, 228, 181, 255, 200, 214, 181, 255, 201, 215, 180, 255, 197, 214, 178, 255, 198, 216, 181, 255, 201, 217, 184, 255, 197, 215, 181, 255, 195, 211, 177, 255, 194, 209, 173, 255, 193, 207, 170, 255, 195, 205, 168, 255, 193, 204, 165, 255, 192, 202, 162, 255, 193, 203, 161, 255, 193, 201, 160, 255, 192, 200, 158, 255, 191, 199, 157, 255, 193, 200, 158, 255, 195, 201, 159, 255, 195, 201, 158, 255, 193, 200, 156, 255, 193, 199, 155, 255, 192, 199, 154, 255, 192, 197, 153, 255, 192, 197, 153, 255, 194, 199, 154, 255, 192, 197, 153, 255, 192, 197, 152, 255, 190, 197, 149, 255, 189, 194, 146, 255, 197, 196, 163, 255, 199, 196, 164, 255, 200, 199, 170, 255, 210, 209, 180, 255, 187, 190, 168, 255, 68, 77, 88, 255, 141, 147, 141, 255, 214, 222, 191, 255, 208, 218, 189, 255, 203, 210, 184, 255, 204, 212, 185, 255, 207, 215, 187, 255, 211, 217, 192, 255, 70, 87, 114, 255, 157, 177, 220, 255, 169, 190, 233, 255, 166, 183, 228, 255, 192, 208, 248, 255, 76, 94, 125, 255, 91, 101, 113, 255, 247, 255, 225, 255, 86, 100, 121, 255, 103, 113, 193, 255, 118, 125, 208, 255, 111, 117, 201, 255, 131, 138, 223, 255, 70, 85, 135, 255, 17, 33, 60, 255, 119, 140, 183, 255, 122, 140, 190, 255, 58, 73, 125, 255, 60, 76, 129, 255, 42, 60, 106, 255, 13, 26, 50, 255, 166, 174, 144, 255, 225, 236, 179, 255, 200, 209, 160, 255, 218, 226, 174, 255, 160, 169, 140, 255, 69, 89, 117, 255, 178, 202, 242, 255, 160, 182, 227, 255, 176, 196, 242, 255, 133, 150, 192, 255, 86, 92, 94, 255, 92, 98, 136, 255, 118, 125, 208, 255, 131, 142, 218, 255, 94, 111, 160, 255, 130, 141, 124, 255, 218, 231, 186, 255, 198, 214, 179, 255, 200, 217, 181, 255, 200, 218, 183, 255, 199, 218, 182, 255, 199, 217, 184, 255, 200, 216, 183, 255, 196, 212, 176, 255, 196, 210, 175, 255, 196, 207, 171, 255, 196, 205, 167, 255, 195, 205, 165, 255, 195, 203, 164, 255, 196, 204, 164, 255, 195, 202, 161, 255, 196, 202, 161, 255, 194, 199, 157, 255, 194, 200, 156, 255, 194, 200, 157, 255, 194, 199, 158, 255, 195, 200, 157, 255, 193, 200, 157, 255, 193, 199, 156, 255, 193, 199, 155, 255, 194, 200, 156, 255, 195, 200, 156, 255, 195, 199, 155, 255, 193, 198, 153, 255, 193, 198, 151, 255, 192, 197, 149, 255, 198, 197, 165, 255, 200, 197, 166, 255, 205, 204, 175, 255, 205, 203, 176, 255, 221, 219, 189, 255, 74, 80, 89, 255, 0, 3, 41, 255, 70, 77, 87, 255, 209, 214, 184, 255, 211, 217, 188, 255, 203, 212, 185, 255, 204, 211, 185, 255, 223, 228, 199, 255, 103, 120, 132, 255, 128, 151, 198, 255, 176, 196, 238, 255, 169, 186, 230, 255, 171, 185, 232, 255, 185, 201, 237, 255, 39, 55, 85, 255, 147, 156, 151, 255, 111, 119, 142, 255, 103, 112, 197, 255, 117, 126, 208, 255, 111, 117, 200, 255, 112, 119, 205, 255, 120, 131, 209, 255, 49, 67, 93, 255, 40, 54, 75, 255, 119, 139, 182, 255, 123, 145, 193, 255, 58, 75, 125, 255, 59, 75, 128, 255, 44, 61, 108, 255, 12, 24, 48, 255, 162, 171, 143, 255, 218, 227, 177, 255, 237, 245, 192, 255, 124, 133, 122, 255, 104, 125, 155, 255, 178, 205, 246, 255, 160, 184, 228, 255, 170, 192, 237, 255, 154, 172, 214, 255, 49, 58, 77, 255, 82, 91, 138, 255, 124, 133, 215, 255, 120, 131, 212, 255, 98, 111, 173, 255, 114, 121, 114, 255, 224, 231, 190, 255, 198, 213, 179, 255, 201, 218, 182, 255, 202, 218, 183, 255, 198, 217, 181, 255, 198, 217, 183, 255, 201, 218, 183, 255, 196, 212, 174, 255, 199, 211, 174, 255, 198, 209, 170, 255, 197, 206, 167, 255, 195, 203, 163, 255, 196, 205, 165, 255, 199, 205, 166, 255, 198, 203, 163, 255, 200, 203, 164, 255, 199, 200, 160, 255, 196, 199, 157, 255, 195, 199, 157, 255, 195, 198, 157, 255, 195, 199, 158, 255, 193, 199, 156, 255, 193, 198, 155, 255, 193, 198, 155, 255, 192, 198, 154, 255, 193, 197, 154, 255, 194, 199, 154, 255, 195, 199, 154, 255, 194, 198, 152, 255, 196, 198, 152, 255, 198, 198, 167, 255, 202, 202, 172, 255, 208, 207, 178, 255, 207, 205, 179, 255, 226, 222, 194, 255, 188, 188, 167, 255, 31, 42, 77, 255, 19, 33, 73, 255, 66, 72, 82, 255, 220, 225, 191, 255, 207, 215, 185, 255, 208, 211, 187, 255, 229, 232, 203, 255, 115, 127, 134, 255, 123, 147, 195, 255, 166, 187, 236, 255, 168, 187, 229, 255, 168, 187, 229, 255, 178, 195, 241, 255, 159, 174, 203, 255, 29, 41, 66, 255, 95, 102, 154, 255, 124, 130, 216, 255, 122, 130, 214, 255, 127, 134, 222, 255, 123, 131, 218, 255, 109, 118, 197, 255, 78, 94, 130, 255, 85, 98, 105, 255, 1, 20, 48, 255, 109, 134, 174, 255, 127, 150, 197, 255, 59, 76, 127, 255, 56, 72, 125, 255, 45, 63, 109, 255, 12, 26, 53, 255, 63, 76, 91, 255, 100, 115, 121, 255, 56, 70, 86, 255, 153, 174, 204, 255, 169, 195, 238, 255, 161, 188, 229, 255, 165, 190, 234, 255, 171, 194, 233, 255, 40, 57, 82, 255, 85, 97, 156, 255, 126, 135, 217, 255, 122, 130, 211, 255, 98, 107, 187, 255, 96, 105, 109, 255, 223, 228, 187, 255, 203, 213, 179, 255, 203, 218, 183, 255, 202, 217, 182, 255, 199, 217, 181, 255, 200, 220, 186, 255, 202, 218, 184, 255, 199, 214, 175, 255, 199, 211, 173, 255, 197, 210, 169, 255, 196, 207, 165, 255, 196, 204, 163, 255, 198, 204, 165, 255, 200, 205, 166, 255, 202, 206, 168, 255, 204, 206, 168, 255, 204, 205, 165, 255, 201, 203, 162, 255, 199, 203, 162, 255, 201, 202, 162, 255, 199, 201, 160, 255, 197, 200, 158, 255, 196, 198, 156, 255, 193, 196, 153, 255, 191, 195, 151, 255, 191, 195, 151, 255, 191, 196, 150, 255, 193, 197, 151, 255, 196, 198, 154, 255, 197, 198, 153, 255, 200, 202, 174, 255, 204, 206, 177, 255, 205, 203, 177, 255, 225, 222, 193, 255, 123, 122, 120, 255, 140, 141, 139, 255, 68, 74, 93, 255, 47, 64, 106, 255, 10, 20, 56, 255, 122, 128, 119, 255, 225, 231, 197, 255, 212, 212, 189, 255, 228, 229, 201, 255, 86, 98, 113, 255, 132, 152, 205, 255, 152, 173, 229, 255, 153, 172, 225, 255, 166, 188, 231, 255, 163, 184, 228, 255, 192, 209, 250, 255, 102, 114, 135, 255, 58, 68, 121, 255, 130, 136, 215, 255, 94, 103, 169, 255, 68, 81, 131, 255, 48, 63, 101, 255, 47, 60, 93, 255, 50, 67, 95, 255, 71, 88, 119, 255, 87, 109, 137, 255, 24, 44, 66, 255, 98, 122, 161, 255, 133, 156, 202, 255, 61, 80, 129, 255, 52, 69, 121, 255, 49, 65, 110, 255, 7, 22, 52, 255, 66, 84, 113, 255, 70, 89, 115, 255, 181, 203, 235, 255, 164, 188, 231, 255, 166, 190, 232, 255, 164, 192, 233, 255, 179, 205, 244, 255, 69, 88, 114, 255, 106, 117, 189, 255, 132, 141, 227, 255, 118, 127, 208, 255, 108, 118, 200, 255, 80, 92, 110, 255, 215, 221, 183, 255, 207, 217, 183, 255, 204, 217, 181, 255, 203, 216, 180, 255, 201, 216, 181, 255, 202, 219, 184, 255, 203, 217, 181, 255, 202, 215, 177, 255, 201, 213, 174, 255, 195, 209, 166, 255, 194, 206, 160, 255, 196, 204, 162, 255, 199, 205, 164, 255, 200, 205, 166, 255, 203, 207, 169, 255, 208, 210, 173, 255, 208, 208, 171, 255, 207, 207, 170, 255, 208, 209, 173, 255, 206, 207, 168, 255, 199, 202, 161, 255, 201, 202, 162, 255, 201, 201, 161, 255, 196, 197, 155, 255, 193, 195, 149, 255, 192, 194, 147, 255, 191, 192, 144, 255, 190, 190, 143, 255, 196, 196, 150, 255, 199, 200, 155, 255, 206, 206, 182, 255, 205, 206, 180, 255, 206, 204, 178, 255, 218, 215, 187, 255, 187, 184, 168, 255, 31, 39, 61, 255, 47, 57, 78, 255, 41, 57, 97, 255, 40, 55, 95, 255, 33, 42, 61, 255, 208, 210, 182, 255, 227, 225, 200, 255, 210, 210, 189, 255, 68, 81, 109, 255, 153, 172, 225, 255, 152, 168, 224, 255, 148, 167, 223, 255, 155, 177, 227, 255, 166, 186, 230, 255, 170, 190, 236, 255, 177, 195, 223, 255, 45, 60, 85, 255, 56, 69, 107, 255, 63, 77, 112, 255, 94, 111, 146, 255, 126, 144, 184, 255, 152, 167, 214, 255, 163, 181, 230, 255, 166, 185, 235, 255, 175, 195, 247, 255, 140, 162, 196, 255, 20, 37, 60, 255, 91, 114, 152, 255, 137, 163, 208, 255, 63, 81, 129, 255, 50, 65, 116, 255, 51, 67, 110, 255, 12, 24, 50, 255, 103, 121, 138, 255, 205, 228, 255, 255, 161, 184, 226, 255, 170, 191, 234, 255, 165, 191, 232, 255, 183, 209, 249, 255, 97, 118, 146, 255, 45, 61, 106, 255, 103, 113, 184, 255, 130, 139, 224, 255, 119, 129, 216, 255, 75, 89, 122, 255, 205, 211, 173, 255, 213, 221, 188, 255, 204, 216, 181, 255, 204, 217, 180, 255, 203, 216, 180, 255, 201, 216, 178, 255, 201, 215, 174, 255, 200, 212, 170, 255, 197, 208, 164, 255, 193, 205, 159, 255, 194, 204, 157, 255, 193, 203, 157, 255, 198, 205, 162, 255, 202, 206, 165, 255, 204, 208, 169, 255, 208, 212, 176, 255, 216, 217, 187, 255, 215, 214, 183, 255, 215, 215, 184, 255, 212, 213, 179, 255, 206, 208, 169, 255, 205, 207, 167, 255, 205, 205, 165, 255, 197, 197, 154, 255, 194, 194, 148, 255, 194, 192, 146, 255, 193, 191, 143, 255, 192, 190, 142, 255, 199, 196, 152, 255, 205, 199, 158, 255, 205, 206, 180, 255, 204, 203, 178, 255, 208, 204, 179, 255, 206, 203, 177, 255, 236, 232, 201, 255, 87, 91, 99, 255, 0, 11, 45, 255, 42, 61, 95, 255, 48, 65, 102, 255, 15, 28, 61, 255, 152, 154, 140, 255, 250, 247, 219, 255, 164, 168, 157, 255, 71, 86, 123, 255, 167, 184, 239, 255, 153, 168, 221, 255, 152, 169, 223, 255, 153, 174, 225, 255, 162, 183, 230, 255, 165, 186, 231, 255, 188, 209, 249, 255, 91, 110, 129, 255, 93, 110, 156, 255, 165, 181, 242, 255, 166, 181, 239, 255, 163, 177, 237, 255, 153, 172, 230, 255, 147, 168, 224, 255, 146, 166, 221, 255, 142, 162, 218, 255, 164, 186, 239, 255, 150, 174, 207, 255, 22, 40, 64, 255, 81, 106, 143, 255, 142, 167, 212, 255, 65, 84, 131, 255, 47, 64, 114, 255, 46, 60, 106, 255, 19, 31, 55, 255, 139, 156, 178, 255, 191, 213, 252, 255, 166, 187, 228, 255, 170, 192, 232, 255, 181, 208, 248, 255, 122, 144, 175, 255, 53, 75, 104, 255, 61, 79, 106, 255, 50, 65, 113, 255, 124, 137, 221, 255, 82, 98, 148, 255, 187, 197, 158, 255, 217, 225, 186, 255, 205, 215, 177, 255, 205, 217, 176, 255, 205, 216, 177, 255, 203, 216, 177, 255, 200, 213, 169, 255, 201, 210, 166, 255, 193, 203, 154, 255, 193, 202, 152, 255, 191, 200, 151, 255, 193, 201, 154, 255, 196, 203, 158, 255, 204, 209, 169, 255, 207, 211, 172, 255, 216, 218, 187, 255, 223, 225, 200, 255, 222, 222, 199, 255, 222, 222, 197, 255, 220, 219, 189, 255, 214, 214, 180, 255, 209, 209, 171, 255, 211, 209, 172, 255, 200, 199, 156, 255, 196, 195, 150, 255, 195, 192, 146, 255, 194, 190, 144, 255, 195, 191, 147, 255, 204, 196, 159, 255, 206, 199, 161, 255, 207, 206, 181, 255, 207, 204, 180, 255, 205, 202, 177, 255, 205, 204, 177, 255, 230, 227, 194, 255, 143, 145, 137, 255, 6, 22, 58, 255, 40, 59, 93, 255, 36, 53, 84, 255, 21, 34, 73, 255, 100, 101, 103, 255, 255, 255, 228, 255, 114, 120, 124, 255, 101, 119, 161, 255, 169, 185, 239, 255, 154, 169, 221, 255, 155, 174, 224, 255, 158, 178, 227, 255, 166, 188, 233, 255, 166, 191, 233, 255, 182, 206, 248, 255, 136, 157, 184, 255, 74, 94, 131, 255, 164, 181, 238, 255, 148, 163, 219, 255, 146, 162, 221, 255, 146, 165, 223, 255, 158, 180, 227, 255, 163, 184, 228, 255, 167, 186, 231, 255, 173, 193, 229, 255, 184, 208, 244, 255, 168, 192, 220, 255, 28, 47, 69, 255, 71, 95, 132, 255, 144, 168, 214, 255, 65, 86, 132, 255, 45, 62, 112, 255, 43, 59, 104, 255, 9, 22, 48, 255, 131, 149, 172, 255, 199, 219, 255, 255, 172, 191, 231, 255, 182, 207, 246, 255, 140, 163, 197, 255, 86, 108, 143, 255, 184, 209, 243, 255, 92, 114, 130, 255, 37, 56, 97, 255, 87, 103, 161, 255, 181, 190, 160, 255, 217, 226, 181, 255, 205, 215, 172, 255, 205, 216, 172, 255, 206, 217, 176, 255, 206, 215, 175, 255, 201, 212, 167, 255, 199, 209, 162, 255, 197, 206, 157, 255, 194, 201, 149, 255, 192, 197, 146, 255, 202, 208, 167, 255, 207, 213, 176, 255, 212, 215, 179, 255, 218, 218, 187, 255, 222, 221, 195, 255, 224, 223, 200, 255, 224, 224, 201, 255, 225, 224, 201, 255, 220, 218, 188, 255, 213, 213, 176, 255, 212, 214, 176, 255, 211, 212, 173, 255, 207, 207, 165, 255, 202, 201, 157, 255, 200, 197, 154, 255, 198, 193, 151, 255, 199, 194, 153, 255, 208, 199, 166, 255, 208, 200, 163, 255, 208, 201, 179, 255, 206, 201, 176, 255, 204, 202, 176, 255, 207, 202, 175, 255, 223, 218, 186, 255, 165, 166, 150, 255, 13, 28, 64, 255, 42, 58, 95, 255, 28, 44, 70, 255, 18, 34, 70, 255, 79, 81, 91, 255, 245, 241, 213, 255, 75, 85, 101, 255, 140, 158, 205, 255, 160, 177, 230, 255, 156, 172, 223, 255, 155, 173, 225, 255, 156, 174, 225, 255, 167, 187, 233, 255, 170, 195, 236, 255, 175, 201, 243, 255, 165, 188, 221, 255, 69, 88, 119, 255, 158, 176, 229, 255, 154, 171, 224, 255, 163, 183, 228, 255, 172, 193, 232, 255, 181, 201, 234, 255, 180, 203, 235, 255, 182, 203, 235, 255, 182, 203, 235, 255, 176, 200, 231, 255, 185, 210, 244, 255, 180, 202, 229, 255, 38, 56, 78, 255, 62, 85, 122, 255, 144, 168, 215, 255, 69, 89, 136, 255, 45, 63, 112, 255, 44, 63, 108, 255, 9, 21, 46, 255, 126, 143, 165, 255, 196, 219, 253, 255, 177, 200, 238, 255, 160, 184, 218, 255, 85, 109, 146, 255, 161, 187, 231, 255, 185, 209, 245, 255, 116, 135, 157, 255, 25, 38, 74, 255, 192, 198, 172, 255, 220, 228, 181, 255, 199, 211, 166, 255, 201, 213, 166, 255, 202, 213, 168, 255, 200, 212, 167, 255, 198, 209, 164, 255, 195, 205, 157, 255, 193, 202, 151, 255, 193, 199, 146, 255, 189, 196, 142, 255, 209, 213, 178, 255, 220, 223, 196, 255, 223, 224, 198, 255, 224, 221, 195, 255, 223, 220, 195, 255, 226, 224, 201, 255, 225, 225, 202, 255, 225, 223, 200, 255, 222, 220, 192, 255, 214, 213, 175, 255, 211, 211, 170, 255, 211, 210, 170, 255, 211, 210, 170, 255, 207, 204, 163, 255, 205, 201, 162, 255, 202, 197, 161, 255, 205, 198, 163, 255, 210, 200, 168, 255, 210, 199, 164, 255, 207, 197, 175, 255, 206, 194, 171, 255, 205, 197, 172, 255, 207, 198, 169, 255, 221, 211, 179, 255, 175, 173, 154, 255, 17, 33, 65, 255, 36, 51, 86, 255, 25, 39, 66, 255, 10, 27, 59, 255, 83, 86, 96, 255, 209, 207, 182, 255, 66, 78, 108, 255, 161, 179, 231, 255, 157, 173, 224, 255, 156, 173, 224, 255, 154, 172, 224, 255, 161, 182, 231, 255, 171, 192, 235, 255, 172, 197, 237, 255, 172, 198, 239, 255, 178, 202, 239, 255, 78, 97, 130, 255, 155, 176, 220, 255, 183, 203, 237, 255, 189, 209, 236, 255, 188, 208, 236, 255, 184, 203, 235, 255, 182, 204, 236, 255, 179, 205, 236, 255, 178, 202, 236, 255, 183, 205, 239, 255, 179, 202, 234, 255, 186, 210, 245, 255, 185, 210, 238, 255, 44, 64, 87, 255, 60, 82, 116, 255, 145, 170, 215, 255, 72, 93, 139, 255, 43, 65, 112, 255, 47, 65, 110, 255, 10, 22, 50, 255, 122, 141, 165, 255, 188, 211, 246, 255, 170, 193, 231, 255, 129, 155, 199, 255, 163, 187, 228, 255, 163, 185, 227, 255, 188, 210, 247, 255, 95, 113, 143, 255, 74, 82, 98, 255, 235, 244, 199, 255, 230, 241, 194, 255, 222, 234, 187, 255, 221, 232, 186, 255, 223, 232, 186, 255, 228, 236, 189, 255, 228, 233, 184, 255, 212, 219, 168, 255, 193, 200, 148, 255, 198, 203, 155, 255, 219, 220, 192, 255, 225, 226, 202, 255, 223, 224, 198, 255, 226, 224, 199, 255, 226, 222, 197, 255, 227, 224, 200, 255, 225, 224, 201, 255, 227, 225, 203, 255, 221, 220, 192, 255, 213, 212, 170, 255, 213, 213, 169, 255, 214, 213, 171, 255, 211, 211, 169, 255, 208, 204, 167, 255, 207, 202, 170, 255, 205, 199, 167, 255, 205, 196, 165, 255, 209, 197, 167, 255, 210, 197, 167, 255, 207, 193, 168, 255, 203, 188, 162, 255, 206, 194, 167, 255, 208, 198, 168, 255, 222, 211, 177, 255, 176, 173, 154, 255, 19, 36, 70, 255, 38, 52, 87, 255, 26, 41, 68, 255, 6, 22, 52, 255, 101, 105, 110, 255, 165, 166, 151, 255, 84, 97, 137, 255, 169, 186, 238, 255, 157, 172, 224, 255, 154, 170, 222, 255, 161, 180, 228, 255, 173, 196, 238, 255, 173, 197, 238, 255, 174, 197, 238, 255, 170, 196, 236, 255, 181, 207, 245, 255, 101, 123, 158, 255, 160, 183, 219, 255, 186, 209, 240, 255, 183, 206, 234, 255, 184, 205, 236, 255, 184, 205, 238, 255, 182, 204, 238, 255, 183, 207, 240, 255, 186, 213, 243, 255, 187, 212, 243, 255, 190, 211, 243, 255, 185, 205, 239, 255, 183, 208, 243, 255, 181, 207, 239, 255, 28, 49, 73, 255, 46, 71, 103, 255, 141, 169, 214, 255, 73, 96, 142, 255, 56, 80, 131, 255, 10, 23, 52, 255, 54, 64, 75, 255, 207, 232, 255, 255, 174, 195, 232, 255, 172, 193, 232, 255, 167, 190, 228, 255, 169, 189, 229, 255, 175, 198, 242, 255, 200, 224, 255, 255, 56, 68, 101, 255, 77, 85, 80, 255, 109, 111, 91, 255, 76, 79, 68, 255, 73, 77, 66, 255, 76, 78, 66, 255, 91, 91, 76, 255, 125, 124, 103, 255, 184, 183, 151, 255, 229, 230, 189, 255, 231, 235, 197, 255, 221, 223, 196, 255, 223, 224, 200, 255, 228, 227, 203, 255, 227, 225, 201, 255, 226, 224, 198, 255, 227, 224, 200, 255, 226, 225, 202, 255, 224, 224, 197, 255, 217, 217, 186, 255, 215, 216, 171, 255, 214, 216, 169, 255, 214, 214, 170, 255, 215, 214, 174, 255, 209, 205, 170, 255, 205, 199, 168, 255, 206, 199, 167, 255, 205, 195, 166, 255, 207, 195, 168, 255, 211, 196, 169, 255, 204, 189, 161, 255, 203, 188, 160, 255, 206, 195, 165, 255, 207, 195, 165, 255, 226, 212, 179, 255, 165, 162, 144, 255, 18, 38, 74, 255, 41, 58, 93, 255, 29, 41, 69, 255, 4, 20, 51, 255, 122, 127, 125, 255, 128, 134, 128, 255, 112, 129, 172, 255, 171, 186, 236, 255, 157, 173, 224, 255, 156, 174, 224, 255, 170, 192, 235, 255, 173, 197, 238, 255, 173, 198, 238, 255, 175, 196, 238, 255, 172, 196, 236, 255, 178, 203, 241, 255, 149, 171, 210, 255, 171, 195, 229, 255, 178, 206, 237, 255, 177, 205, 237, 255, 183, 209, 240, 255, 184, 209, 240, 255, 183, 208, 240, 255, 187, 210, 242, 255, 188, 213, 243, 255, 187, 213, 244, 255, 188, 212, 244, 255, 190, 210, 243, 255, 180, 203, 237, 255, 177, 202, 238, 255, 100, 125, 156, 255, 59, 79, 98, 255, 39, 61, 94, 255, 137, 164, 212, 255, 48, 65, 101, 255, 58, 68, 66, 255, 51, 55, 49, 255, 112, 125, 140, 255, 202, 226, 255, 255, 175, 194, 233, 255, 190, 211, 250, 255, 196, 216, 255, 255, 135, 149, 178, 255, 55, 63, 73, 255, 63, 66, 63, 255, 93, 96, 94, 255, 125, 130, 128, 255, 141, 147, 145, 255, 145, 151, 150, 255, 142, 149, 147, 255, 133, 137, 136, 255, 103, 104, 104, 255, 59, 59, 58, 255, 62, 61, 54, 255, 155, 156, 140, 255, 242, 245, 221, 255, 231, 234, 210, 255, 223, 224, 201, 255, 227, 229, 204, 255, 226, 225, 200, 255, 224, 224, 198, 255, 224, 224, 196, 255, 222, 221, 194, 255, 219, 218, 183, 255, 215, 217, 171, 255, 215, 215, 168, 255, 217, 216, 173, 255, 217, 216, 175, 255, 211, 208, 173, 255, 205, 200, 169, 255, 205, 199, 169, 255, 207, 197, 169, 255, 208, 196, 171, 255, 211, 197, 170, 255, 202, 189, 158, 255, 202, 188, 158, 255, 207, 192, 164, 255, 209, 193, 165, 255, 228, 212, 179, 255, 149, 147, 134, 255, 19, 40, 79, 255, 42, 61, 96, 255, 28, 41, 68, 255, 6, 19, 50, 255, 144, 149, 145, 255, 110, 118, 125, 255, 138, 158, 202, 255, 165, 181, 231, 255, 157, 172, 223, 255, 166, 184, 230, 255, 172, 193, 236, 255, 171, 195, 237, 255, 172, 198, 239, 255, 174, 196, 238, 255, 175, 198, 238, 255, 174, 199, 237, 255, 177, 202, 238, 255, 179, 205, 238, 255, 178, 205, 238, 255, 181, 208, 239, 255, 182, 211, 241, 255, 185, 211, 242, 255, 186, 210, 241, 255, 184, 210, 241, 255, 187, 212, 243, 255, 188, 213, 244, 255, 187, 213, 244, 255, 187, 213, 243, 255, 185, 207, 241, 255, 179, 199, 236, 255, 148, 173, 206, 255, 201, 228, 252, 255, 78, 98, 118, 255, 16, 33, 61, 255, 27, 33, 39, 255, 216, 220, 215, 255, 154, 160, 152, 255, 33, 34, 30, 255, 109, 121, 137, 255, 209, 226, 255, 255, 115, 126, 145, 255, 49, 55, 62, 255, 62, 63, 60, 255, 144, 148, 139, 255, 163, 168, 159, 255, 142, 144, 139, 255, 122, 125, 122, 255, 112, 119, 115, 255, 114, 119, 115, 255, 116, 121, 117, 255, 124, 128, 126, 255, 146, 148, 146, 255, 177, 180, 176, 255, 158, 162, 158, 255, 62, 64, 63, 255, 68, 68, 64, 255, 217, 220, 203, 255, 238, 243, 216, 255, 221, 225, 200, 255, 227, 228, 204, 255, 223, 223, 193, 255, 222, 222, 189, 255, 224, 222, 188, 255, 219, 219, 177, 255, 217, 217, 172, 255, 217, 217, 174, 255, 217, 217, 175, 255, 217, 216, 177, 255, 212, 207, 177, 255, 207, 202, 173, 255, 208, 200, 173, 255, 208, 197, 173, 255, 208, 195, 170, 255, 208, 194, 167, 255, 203, 188, 157, 255, 204, 186, 156, 255, 207, 190, 161, 255, 209, 192, 162, 255, 232, 216, 182, 255, 142, 141, 133, 255, 20, 43, 83, 255, 47, 69, 106, 255, 32, 45, 76, 255, 11, 24, 53, 255, 161, 163, 155, 255, 98, 108, 121, 255, 155, 178, 220, 255, 160, 177, 226, 255, 162, 176, 226, 255, 170, 188, 233, 255, 173, 192, 235, 255, 174, 196, 239, 255, 173, 197, 239, 255, 175, 197, 239, 255, 176, 199, 238, 255, 175, 199, 237, 255, 175, 200, 237, 255, 180, 205, 239, 255, 181, 206, 240, 255, 184, 209, 241, 255, 184, 213, 243, 255, 184, 214, 244, 255, 186, 213, 243, 255, 186, 211, 242, 255, 185, 211, 242, 255, 186, 214, 244, 255, 186, 214, 244, 255, 187, 213, 244, 255, 184, 208, 241, 255, 184, 205, 240, 255, 189, 211, 244, 255, 191, 212, 245, 255, 196, 219, 247, 255, 154, 178, 200, 255, 104, 118, 126, 255, 72, 72, 71, 255, 221, 223, 221, 255, 153, 162, 154, 255, 54, 59, 55, 255, 43, 45, 48, 255, 36, 39, 35, 255, 94, 96, 92, 255, 170, 172, 167, 255, 105, 109, 106, 255, 103, 110, 113, 255, 130, 135, 140, 255, 161, 164, 154, 255, 214, 214, 185, 255, 212, 214, 184, 255, 209, 211, 181, 255, 193, 195, 169, 255, 157, 157, 138, 255, 111, 111, 103, 255, 112, 115, 114, 255, 186, 191, 185, 255, 136, 140, 134, 255, 37, 37, 36, 255, 192, 195, 180, 255, 243, 249, 225, 255, 222, 226, 202, 255, 223, 223, 193, 255, 225, 223, 191, 255, 224, 221, 186, 255, 221, 218, 177, 255, 220, 219, 176, 255, 219, 218, 178, 255, 218, 217, 177, 255, 214, 211, 175, 255, 211, 208, 177, 255, 210, 204, 177, 255, 210, 201, 176, 255, 207, 197, 173, 255, 207, 196, 171, 255, 207, 194, 167, 255, 202, 188, 154, 255, 205, 187, 154, 255, 210, 190, 159, 255, 207, 188, 159, 255, 234, 216, 184, 255, 143, 141, 135, 255, 24, 46, 87, 255, 53, 78, 117, 255, 37, 54, 90, 255, 16, 30, 57, 255, 163, 165, 156, 255, 93, 106, 123, 255, 162, 185, 230, 255, 159, 175, 224, 255, 162, 179, 228, 255, 165, 184, 231, 255, 174, 195, 237, 255, 176, 197, 238, 255, 174, 197, 239, 255, 174, 198, 239, 255, 175, 198, 238, 255, 177, 202, 238, 255, 160, 182, 227, 255, 165, 191, 231, 255, 184, 209, 241, 255, 185, 211, 242, 255, 186, 213, 244, 255, 185, 214, 244, 255, 185, 214, 244, 255, 185, 212, 242, 255, 184, 211, 241, 255, 186, 213, 243, 255, 186, 214, 244, 255, 185, 213, 243, 255, 183, 208, 241, 255, 183, 206, 240, 255, 186, 208, 241, 255, 190, 209, 242, 255, 187, 210, 242, 255, 191, 218, 251, 255, 206, 235, 255, 255, 124, 142, 157, 255, 69, 71, 71, 255, 222, 226, 223, 255, 130, 140, 135, 255, 47, 53, 51, 255, 201, 209, 201, 255, 117, 124, 121, 255, 81, 87, 93, 255, 168, 175, 188, 255, 222, 231, 245, 255, 219, 226, 236, 255, 183, 188, 186, 255, 229, 230, 210, 255, 233, 232, 209, 255, 229, 228, 202, 255, 226, 229, 196, 255, 231, 235, 191, 255, 231, 236, 189, 255, 185, 187, 157, 255, 95, 97, 90, 255, 155, 159, 155, 255, 177, 182, 175, 255, 35, 35, 35, 255, 195, 198, 183, 255, 238, 244, 217, 255, 222, 222, 194, 255, 226, 223, 191, 255, 224, 221, 185, 255, 224, 221, 185, 255, 220, 220, 184, 255, 216, 218, 179, 255, 214, 215, 177, 255, 212, 210, 178, 255, 212, 206, 177, 255, 212, 202, 177, 255, 211, 201, 177, 255, 208, 197, 173, 255, 207, 195, 170, 255, 207, 193, 167, 255, 202, 187, 152, 255, 205, 187, 153, 255, 208, 189, 158, 255, 205, 185, 156, 255, 233, 214, 185, 255, 154, 150, 143, 255, 36, 55, 97, 255, 60, 86, 127, 255, 43, 63, 103, 255, 23, 35, 63, 255, 161, 164, 155, 255, 98, 110, 129, 255, 168, 191, 235, 255, 159, 176, 225, 255, 161, 179, 226, 255, 165, 187, 232, 255, 174, 197, 238, 255, 175, 196, 238, 255, 176, 196, 240, 255, 173, 197, 239, 255, 174, 197, 237, 255, 177, 203, 239, 255, 152, 174, 222, 255, 153, 177, 223, 255, 186, 211, 243, 255, 186, 212, 242, 255, 188, 213, 244, 255, 186, 214, 244, 255, 186, 213, 244, 255, 185, 212, 242, 255, 182, 211, 241, 255, 185, 213, 243, 255, 187, 214, 244, 255, 185, 213, 243, 255, 184, 209, 241, 255, 183, 208, 241, 255, 185, 210, 242, 255, 189, 212, 243, 255, 190, 214, 244, 255, 187, 210, 241, 255, 178, 201, 233, 255, 212, 240, 255, 255, 102, 115, 127, 255, 96, 98, 96, 255, 81, 91, 88, 255, 191, 202, 193, 255, 109, 117, 111, 255, 96, 104, 112, 255, 211, 219, 239, 255, 210, 216, 236, 255, 198, 205, 224, 255, 207, 215, 229, 255, 178, 181, 188, 255, 198, 200, 195, 255, 238, 240, 221, 255, 234, 234, 220, 255, 232, 235, 219, 255, 225, 230, 206, 255, 218, 223, 187, 255, 226, 229, 178, 255, 230, 231, 182, 255, 115, 115, 100, 255, 136, 138, 138, 255, 177, 182, 175, 255, 41, 41, 40, 255, 222, 224, 205, 255, 233, 232, 204, 255, 227, 223, 193, 255, 225, 222, 191, 255, 226, 223, 197, 255, 224, 224, 197, 255, 222, 221, 194, 255, 220, 222, 194, 255, 219, 218, 191, 255, 213, 208, 181, 255, 212, 202, 179, 255, 214, 201, 179, 255, 211, 198, 175, 255, 208, 194, 172, 255, 212, 197, 175, 255, 204, 185, 151, 255, 205, 186, 152, 255, 207, 188, 156, 255, 207, 189, 161, 255, 229, 211, 184, 255, 178, 170, 158, 255, 47, 64, 103, 255, 72, 95, 137, 255, 44, 66, 105, 255, 20, 35, 63, 255, 154, 158, 150, 255, 99, 112, 129, 255, 172, 194, 234, 255, 159, 177, 225, 255, 160, 180, 227, 255, 169, 193, 235, 255, 173, 197, 238, 255, 174, 196, 238, 255, 177, 196, 239, 255, 175, 196, 238, 255, 173, 195, 237, 255, 178, 204, 240, 255, 158, 182, 225, 255, 143, 166, 215, 255, 185, 211, 242, 255, 186, 212, 243, 255, 187, 213, 244, 255, 187, 214, 244, 255, 186, 214, 244, 255, 186, 210, 242, 255, 185, 210, 242, 255, 185, 212, 243, 255, 188, 213, 244, 255, 187, 213, 244, 255, 184, 212, 242, 255, 183, 211, 242, 255, 184, 212, 242, 255, 186, 214, 244, 255, 188, 215, 244, 255, 189, 212, 244, 255, 185, 207, 239, 255, 194, 219, 253, 255, 137, 152, 168, 255, 12, 14, 12, 255, 175, 182, 176, 255, 137, 148, 139, 255, 87, 96, 103, 255, 217, 227, 246, 255, 191, 200, 222, 255, 187, 196, 219, 255, 194, 202, 224, 255, 200, 208, 230, 255, 176, 182, 198, 255, 158, 161, 164, 255, 231, 233, 212, 255, 231, 232, 213, 255, 228, 231, 212, 255, 227, 233, 212, 255, 232, 236, 220, 255, 226, 231, 207, 255, 216, 220, 175, 255, 241, 244, 195, 255, 112, 113, 103, 255, 159, 164, 160, 255, 140, 143, 139, 255, 79, 79, 75, 255, 249, 248, 223, 255, 225, 223, 196, 255, 223, 223, 195, 255, 221, 218, 193, 255, 223, 219, 195, 255, 220, 217, 192, 255, 220, 217, 193, 255, 218, 214, 191, 255, 213, 208, 184, 255, 214, 206, 183, 255, 214, 203, 182, 255, 212, 198, 176, 255, 213, 198, 177, 255, 212, 197, 176, 255, 205, 187, 152, 255, 204, 185, 151, 255, 212, 194, 164, 255, 212, 194, 169, 255, 221, 204, 179, 255, 212, 202, 184, 255, 58, 69, 100, 255, 87, 108, 153, 255, 47, 68, 106, 255, 14, 31, 63, 255, 142, 147, 142, 255, 100, 115, 127, 255, 172, 194, 229, 255, 156, 173, 224, 255, 161, 184, 229, 255, 171, 197, 236, 255, 172, 198, 238, 255, 172, 197, 238, 255, 175, 197, 238, 255, 175, 197, 238, 255, 175, 197, 237, 255, 180, 202, 240, 255, 164, 187, 228, 255, 129, 152, 201, 255, 184, 210, 241, 255, 186, 215, 244, 255, 186, 213, 244, 255, 188, 213, 244, 255, 187, 213, 244, 255, 185, 211, 242, 255, 185, 209, 242, 255, 183, 209, 242, 255, 184, 210, 242, 255, 186, 212, 243, 255, 185, 213, 243, 255, 185, 213, 243, 255, 184, 212, 243, 255, 186, 214, 244, 255, 187, 215, 245, 255, 188, 214, 244, 255, 184, 209, 240, 255, 194, 218, 252, 255, 165, 182, 205, 255, 63, 63, 62, 255, 188, 191, 185, 255, 70, 79, 82, 255, 202, 212, 230, 255, 194, 204, 226, 255, 185, 196, 219, 255, 182, 191, 217, 255, 183, 192, 218, 255, 190, 197, 224, 255, 186, 193, 217, 255, 129, 135, 149, 255, 207, 211, 192, 255, 235, 238, 213, 255, 239, 241, 231, 255, 238, 242, 231, 255, 231, 234, 214, 255, 233, 236, 220, 255, 234, 237, 223, 255, 223, 229, 200, 255, 233, 239, 209, 255, 89, 94, 89, 255, 209, 211, 209, 255, 76, 75, 76, 255, 171, 169, 156, 255, 241, 241, 214, 255, 223, 225, 198, 255, 241, 239, 211, 255, 238, 234, 206, 255, 234, 228, 202, 255, 239, 233, 204, 255, 240, 233, 204, 255, 231, 224, 198, 255, 217, 210, 189, 255, 215, 207, 187, 255, 216, 204, 183, 255, 217, 201, 181, 255, 214, 202, 181, 255, 208, 190, 156, 255, 213, 196, 166, 255, 222, 206, 183, 255, 214, 199, 174, 255, 215, 199, 179, 255, 242, 232, 211, 255, 86, 90, 105, 255, 89, 113, 160, 255, 64, 83, 122, 255, 16, 32, 68, 255, 109, 115, 119, 255, 102, 115, 125, 255, 181, 204, 223, 255, 198, 218, 243, 255, 167, 190, 230, 255, 172, 197, 236, 255, 173, 197, 238, 255, 172, 198, 238, 255, 173, 198, 238, 255, 175, 197, 238, 255, 174, 196, 237, 255, 179, 202, 239, 255, 171, 194, 234, 255, 139, 161, 207, 255, 190, 213, 244, 255, 186, 214, 244, 255, 186, 214, 244, 255, 187, 213, 244, 255, 187, 213, 244, 255, 185, 212, 243, 255, 184, 210, 242, 255, 183, 207, 241, 255, 180, 207, 240, 255, 183, 210, 242, 255, 186, 213, 243, 255, 185, 214, 243, 255, 184, 212, 243, 255, 186, 213, 243, 255, 189, 214, 245, 255, 188, 214, 244, 255, 180, 208, 238, 255, 202, 229, 255, 255, 81, 90, 101, 255, 148, 149, 143, 255, 114, 117, 115, 255, 152, 161, 176, 255, 207, 216, 238, 255, 183, 193, 218, 255, 177, 190, 216, 255, 177, 189, 215, 255, 172, 182, 215, 255, 176, 183, 216, 255, 188, 196, 227, 255, 117, 126, 147, 255, 168, 174, 160, 255, 233, 238, 200, 255, 224, 227, 199, 255, 242, 243, 231, 255, 249, 248, 245, 255, 237, 237, 224, 255, 232, 235, 219, 255, 233, 238, 225, 255, 235, 243, 219, 255, 182, 189, 168, 255, 118, 122, 122, 255, 195, 196, 195, 255, 68, 67, 64, 255, 251, 252, 226, 255, 225, 228, 203, 255, 136, 139, 140, 255, 83, 90, 102, 255, 81, 87, 102, 255, 90, 93, 104, 255, 122, 123, 124, 255, 178, 177, 161, 255, 229, 225, 197, 255, 225, 219, 195, 255, 214, 208, 186, 255, 217, 207, 185, 255, 214, 206, 184, 255, 208, 192, 158, 255, 221, 206, 182, 255, 229, 215, 198, 255, 225, 211, 194, 255, 222, 209, 193, 255, 240, 230, 211, 255, 170, 170, 165, 255, 53, 73, 111, 255, 100, 124, 166, 255, 28, 43, 82, 255, 57, 64, 88, 255, 97, 105, 114, 255, 168, 184, 199, 255, 232, 253, 255, 255, 180, 203, 234, 255, 170, 195, 235, 255, 174, 199, 238, 255, 172, 197, 237, 255, 172, 198, 238, 255, 173, 197, 237, 255, 172, 196, 237, 255, 180, 200, 238, 255, 178, 202, 238, 255, 178, 202, 238, 255, 190, 213, 244, 255, 187, 213, 244, 255, 186, 214, 244, 255, 187, 213, 244, 255, 187, 213, 244, 255, 186, 213, 244, 255, 184, 211, 242, 255, 185, 210, 242, 255, 183, 209, 241, 255, 184, 209, 241, 255, 185, 211, 243, 255, 187, 213, 244, 255, 185, 212, 243, 255, 185, 213, 243, 255, 187, 214, 245, 255, 186, 213, 243, 255, 187, 215, 246, 255, 178, 203, 229, 255, 64, 68, 69, 255, 174, 177, 171, 255, 96, 98, 103, 255, 212, 220, 241, 255, 191, 197, 221, 255, 182, 192, 219, 255, 172, 187, 215, 255, 167, 180, 213, 255, 168, 177, 213, 255, 171, 180, 214, 255, 185, 194, 229, 255, 135, 145, 173, 255, 131, 138, 137, 255, 231, 235, 194, 255, 218, 220, 180, 255, 219, 218, 176, 255, 233, 233, 210, 255, 252, 250, 248, 255, 239, 241, 229, 255, 235, 239, 224, 255, 230, 236, 221, 255, 239, 246, 219, 255, 102, 109, 100, 255, 202, 207, 206, 255, 85, 87, 85, 255, 171, 175, 163, 255, 66, 77, 95, 255, 28, 43, 80, 255, 76, 87, 137, 255, 79, 88, 147, 255, 80, 89, 142, 255, 57, 67, 113, 255, 38, 50, 86, 255, 65, 75, 90, 255, 195, 194, 178, 255, 227, 224, 198, 255, 212, 208, 186, 255, 214, 210, 188, 255, 217, 204, 178, 255, 226, 215, 196, 255, 228, 216, 202, 255, 230, 216, 204, 255, 229, 217, 204, 255, 225, 215, 202, 255, 242, 238, 221, 255, 94, 103, 114, 255, 62, 83, 127, 255, 84, 103, 144, 255, 38, 50, 86, 255, 38, 45, 67, 255, 121, 131, 148, 255, 236, 255, 255, 255, 193, 215, 238, 255, 170, 194, 234, 255, 174, 197, 237, 255, 171, 196, 237, 255, 169, 196, 236, 255, 170, 196, 237, 255, 170, 196, 236, 255, 175, 200, 238, 255, 184, 205, 241, 255, 183, 206, 241, 255, 186, 211, 242, 255, 189, 212, 244, 255, 189, 212, 244, 255, 188, 213, 244, 255, 188, 213, 244, 255, 186, 213, 244, 255, 183, 212, 242, 255, 184, 213, 242, 255, 184, 211, 242, 255, 183, 209, 241, 255, 184, 210, 241, 255, 186, 212, 243, 255, 188, 213, 244, 255, 186, 213, 243, 255, 186, 213, 243, 255, 184, 209, 240, 255, 199, 226, 255, 255, 133, 151, 171, 255, 102, 105, 101, 255, 142, 148, 143, 255, 134, 139, 151, 255, 215, 221, 245, 255, 185, 194, 219, 255, 174, 184, 218, 255, 165, 176, 216, 255, 167, 177, 215, 255, 166, 176, 212, 255, 167, 178, 212, 255, 177, 186, 222, 255, 161, 171, 202, 255, 105, 114, 123, 255, 221, 222, 186, 255, 223, 223, 179, 255, 220, 220, 176, 255, 215, 217, 169, 255, 229, 231, 203, 255, 249, 251, 246, 255, 237, 239, 225, 255, 235, 238, 225, 255, 243, 248, 227, 255, 156, 165, 144, 255, 143, 151, 150, 255, 161, 163, 158, 255, 0, 7, 28, 255, 59, 73, 119, 255, 115, 122, 184, 255, 127, 132, 204, 255, 152, 159, 230, 255, 168, 174, 241, 255, 172, 178, 242, 255, 169, 175, 239, 255, 103, 113, 168, 255, 34, 45, 73, 255, 202, 203, 185, 255, 221, 221, 196, 255, 211, 208, 188, 255, 224, 210, 192, 255, 224, 214, 196, 255, 227, 217, 202, 255, 229, 219, 207, 255, 231, 221, 209, 255, 230, 220, 209, 255, 226, 222, 208, 255, 231, 231, 217, 255, 84, 92, 110, 255, 51, 69, 111, 255, 63, 82, 126, 255, 34, 49, 90, 255, 47, 57, 84, 255, 219, 234, 242, 255, 216, 234, 249, 255, 180, 203, 235, 255, 184, 207, 240, 255, 185, 208, 240, 255, 180, 205, 239, 255, 175, 201, 238, 255, 173, 199, 237, 255, 176, 204, 239, 255, 184, 212, 243, 255, 183, 210, 242, 255, 183, 209, 242, 255, 188, 210, 242, 255, 187, 210, 242, 255, 187, 212, 243, 255, 188, 213, 244, 255, 186, 212, 243, 255, 186, 213, 243, 255, 186, 214, 243, 255, 185, 212, 242, 255, 182, 206, 240, 255, 183, 209, 241, 255, 186, 212, 243, 255, 188, 213, 244, 255, 187, 214, 244, 255, 185, 213, 243, 255, 182, 208, 238, 255, 204, 231, 255, 255, 98, 110, 124, 255, 137, 139, 133, 255, 119, 126, 122, 255, 174, 182, 197, 255, 208, 214, 238, 255, 187, 196, 224, 255, 173, 182, 219, 255, 164, 172, 215, 255, 173, 181, 219, 255, 162, 174, 212, 255, 158, 171, 209, 255, 171, 180, 216, 255, 177, 186, 221, 255, 99, 111, 131, 255, 197, 200, 172, 255, 229, 230, 183, 255, 217, 219, 172, 255, 217, 221, 176, 255, 213, 219, 169, 255, 230, 234, 206, 255, 244, 245, 238, 255, 237, 238, 226, 255, 241, 242, 229, 255, 206, 214, 190, 255, 112, 122, 119, 255, 191, 196, 188, 255, 31, 36, 54, 255, 123, 132, 201, 255, 133, 137, 203, 255, 166, 174, 235, 255, 167, 175, 236, 255, 169, 177, 236, 255, 170, 177, 236, 255, 171, 177, 238, 255, 193, 199, 255, 255, 114, 127, 171, 255, 62, 71, 88, 255, 224, 225, 201, 255, 214, 213, 192, 255, 226, 210, 195, 255, 225, 213, 198, 255, 227, 218, 204, 255, 228, 218, 206, 255, 228, 219, 207, 255, 229, 219, 208, 255, 227, 218, 208, 255, 226, 224, 209, 255, 233, 232, 214, 255, 151, 153, 152, 255, 84, 91, 112, 255, 60, 72, 108, 255, 9, 20, 60, 255, 128, 138, 155, 255, 247, 255, 255, 255, 200, 220, 238, 255, 189, 215, 241, 255, 189, 216, 243, 255, 190, 216, 243, 255, 189, 216, 243, 255, 186, 214, 243, 255, 186, 214, 243, 255, 186, 215, 244, 255, 184, 213, 243, 255, 182, 210, 241, 255, 184, 208, 240, 255, 183, 210, 240, 255, 185, 211, 241, 255, 188, 212, 243, 255, 186, 211, 242, 255, 188, 214, 243, 255, 187, 214, 244, 255, 186, 213, 243, 255, 183, 209, 241, 255, 183, 210, 241, 255, 186, 212, 242, 255, 188, 213, 244, 255, 187, 213, 244, 255, 186, 214, 244, 255, 182, 210, 240, 255, 203, 230, 255, 255, 81, 92, 101, 255, 156, 156, 151, 255, 112, 119, 117, 255, 196, 206, 222, 255, 200, 208, 232, 255, 188, 198, 225, 255, 175, 183, 219, 255, 165, 174, 217, 255, 174, 182, 220, 255, 162, 172, 212, 255, 156, 168, 208, 255, 167, 176, 213, 255, 182, 190, 226, 255, 113, 124, 153, 255, 163, 169, 151, 255, 235, 236, 188, 255, 216, 220, 171, 255, 216, 221, 172, 255, 215, 221, 171, 255, 214, 221, 175, 255, 231, 235, 213, 255, 239, 240, 231, 255, 241, 243, 230, 255, 210, 217, 198, 255, 97, 103, 103, 255, 195, 199, 189, 255, 52, 55, 69, 255, 146, 153, 221, 255, 177, 184, 242, 255, 169, 178, 237, 255, 168, 178, 237, 255, 167, 179, 237, 255, 167, 179, 237, 255, 169, 180, 238, 255, 166, 175, 235, 255, 197, 206, 255, 255, 71, 82, 114, 255, 135, 137, 135, 255, 232, 231, 206, 255, 226, 211, 197, 255, 226, 212, 200, 255, 228, 216, 204, 255, 228, 216, 204, 255, 227, 218, 205, 255, 229, 220, 208, 255, 232, 220, 209, 255, 225, 217, 204, 255, 218, 213, 197, 255, 236, 233, 213, 255, 232, 231, 212, 255, 211, 208, 195, 255, 176, 175, 173, 255, 38, 45, 74, 255, 181, 190, 203, 255, 242, 255, 255, 255, 200, 220, 239, 255, 191, 213, 240, 255, 190, 212, 243, 255, 190, 214, 243, 255, 189, 215, 243, 255, 187, 216, 243, 255, 185, 216, 243, 255, 186, 215, 243, 255, 183, 212, 242, 255, 185, 214, 240, 255, 189, 218, 241, 255, 188, 213, 241, 255, 189, 213, 242, 255, 188, 213, 243, 255, 191, 212, 242, 255, 189, 213, 243, 255, 188, 213, 243, 255, 186, 212, 242, 255, 186, 213, 243, 255, 187, 215, 243, 255, 187, 214, 243, 255, 187, 213, 244, 255, 187, 213, 244, 255, 183, 211, 241, 255, 199, 228, 255, 255, 75, 84, 91, 255, 165, 163, 160, 255, 112, 121, 119, 255, 210, 222, 235, 255, 200, 207, 231, 255, 186, 196, 224, 255, 174, 182, 219, 255, 170, 177, 218, 255, 173, 180, 219, 255, 168, 176, 216, 255, 156, 165, 209, 255, 161, 169, 211, 255, 182, 189, 226, 255, 139, 148, 180, 255, 132, 139, 135, 255, 230, 234, 183, 255, 214, 219, 170, 255, 215, 223, 173, 255, 217, 223, 178, 255, 218, 223, 180, 255, 218, 224, 186, 255, 230, 234, 218, 255, 247, 247, 231, 255, 188, 196, 188, 255, 81, 88, 92, 255, 191, 193, 184, 255, 61, 64, 72, 255, 164, 173, 233, 255, 172, 179, 240, 255, 171, 177, 238, 255, 172, 179, 239, 255, 170, 180, 239, 255, 170, 180, 239, 255, 170, 181, 240, 255, 168, 181, 236, 255, 182, 190, 249, 255, 146, 156, 193, 255, 64, 72, 87, 255, 223, 223, 203, 255, 226, 211, 200, 255, 225, 210, 199, 255, 227, 214, 202, 255, 224, 211, 199, 255, 218, 208, 194, 255, 225, 216, 203, 255, 230, 219, 208, 255, 224, 212, 199, 255, 218, 210, 194, 255, 214, 210, 193, 255, 213, 212, 193, 255, 221, 222, 200, 255, 240, 240, 212, 255, 175, 176, 167, 255, 30, 38, 70, 255, 171, 184, 197, 255, 244, 255, 255, 255, 213, 233, 250, 255, 194, 214, 240, 255, 187, 209, 239, 255, 187, 210, 240, 255, 187, 211, 241, 255, 184, 213, 241, 255, 183, 213, 241, 255, 183, 212, 240, 255, 187, 217, 239, 255, 193, 223, 241, 255, 191, 219, 240, 255, 189, 214, 240, 255, 191, 217, 243, 255, 192, 215, 244, 255, 192, 215, 245, 255, 191, 218, 247, 255, 194, 220, 247, 255, 192, 217, 245, 255, 187, 213, 243, 255, 183, 211, 240, 255, 183, 211, 241, 255, 184, 211, 242, 255, 184, 211, 240, 255, 199, 227, 255, 255, 73, 84, 91, 255, 169, 168, 165, 255, 113, 119, 118, 255, 212, 226, 236, 255, 213, 222, 238, 255, 192, 199, 226, 255, 175, 184, 220, 255, 174, 184, 221, 255, 172, 179, 219, 255, 168, 174, 216, 255, 159, 166, 212, 255, 161, 167, 211, 255, 176, 182, 219, 255, 162, 171, 204, 255, 112, 121, 131, 255, 217, 223, 176, 255, 219, 224, 173, 255, 214, 222, 171, 255, 215, 220, 172, 255, 215, 219, 171, 255, 217, 224, 186, 255, 227, 234, 205, 255, 231, 235, 218, 255, 137, 145, 146, 255, 84, 90, 95, 255, 188, 188, 180, 255, 66, 68, 75, 255, 165, 173, 231, 255, 173, 180, 241, 255, 169, 176, 236, 255, 171, 179, 237, 255, 172, 182, 240, 255, 171, 181, 239, 255, 171, 181, 239, 255, 170, 183, 238, 255, 168, 179, 237, 255, 181, 190, 235, 255, 56, 68, 89, 255, 187, 190, 176, 255, 225, 214, 201, 255, 226, 213, 201, 255, 223, 210, 198, 255, 220, 205, 192, 255, 216, 203, 190, 255, 218, 208, 194, 255, 225, 213, 200, 255, 226, 212, 199, 255, 221, 209, 194, 255, 219, 211, 193, 255, 213, 213, 189, 255, 209, 212, 186, 255, 205, 209, 182, 255, 232, 233, 200, 255, 176, 177, 160, 255, 34, 45, 72, 255, 96, 110, 136, 255, 198, 214, 226, 255, 232, 250, 255, 255, 228, 247, 255, 255, 214, 236, 253, 255, 206, 226, 248, 255, 203, 224, 247, 255, 201, 226, 248, 255, 207, 233, 252, 255, 216, 241, 255, 255, 222, 244, 255, 255, 223, 247, 255, 255, 221, 244, 255, 255, 218, 240, 252, 255, 213, 234, 246, 255, 206, 225, 239, 255, 201, 220, 235, 255, 203, 222, 235, 255, 208, 226, 240, 255, 211, 231, 248, 255, 212, 236, 255, 255, 197, 222, 246, 255, 184, 210, 238, 255, 181, 206, 237, 255, 202, 227, 255, 255, 79, 91, 100, 255, 167, 169, 163, 255, 117, 124, 121, 255, 204, 214, 225, 255, 218, 230, 243, 255, 213, 220, 237, 255, 187, 195, 225, 255, 173, 184, 220, 255, 176, 183, 221, 255, 171, 176, 217, 255, 160, 167, 212, 255, 159, 165, 211, 255, 171, 177, 215, 255, 179, 187, 220, 255, 111, 123, 141, 255, 195, 202, 167, 255, 227, 231, 182, 255, 216, 220, 173, 255, 211, 217, 163, 255, 217, 222, 179, 255, 225, 232, 199, 255, 232, 238, 212, 255, 136, 144, 140, 255, 138, 145, 158, 255, 104, 109, 114, 255, 181, 181, 173, 255, 65, 67, 78, 255, 172, 182, 241, 255, 170, 179, 238, 255, 180, 189, 246, 255, 181, 190, 246, 255, 172, 181, 239, 255, 172, 181, 240, 255, 172, 182, 239, 255, 173, 181, 238, 255, 177, 187, 244, 255, 190, 203, 252, 255, 71, 83, 109, 255, 149, 154, 146, 255, 224, 214, 200, 255, 222, 211, 198, 255, 219, 206, 193, 255, 216, 201, 188, 255, 215, 200, 187, 255, 215, 204, 190, 255, 217, 207, 192, 255, 217, 204, 190, 255, 218, 205, 189, 255, 217, 208, 186, 255, 215, 210, 185, 255, 213, 212, 184, 255, 210, 213, 183, 255, 208, 211, 180, 255, 228, 228, 188, 255, 209, 210, 175, 255, 93, 99, 104, 255, 41, 53, 82, 255, 78, 94, 122, 255, 135, 152, 173, 255, 181, 198, 213, 255, 206, 223, 234, 255, 207, 223, 235, 255, 200, 215, 228, 255, 184, 200, 215, 255, 158, 177, 193, 255, 133, 149, 171, 255, 106, 124, 149, 255, 81, 101, 129, 255, 62, 81, 111, 255, 48, 68, 98, 255, 42, 60, 90, 255, 40, 56, 87, 255, 39, 54, 85, 255, 42, 57, 87, 255, 49, 64, 94, 255, 92, 108, 130, 255, 205, 223, 233, 255, 220, 244, 255, 255, 182, 207, 234, 255, 197, 225, 255, 255, 93, 108, 122, 255, 151, 153, 149, 255, 132, 139, 134, 255, 183, 190, 198, 255, 224, 236, 248, 255, 214, 224, 237, 255, 214, 224, 238, 255, 187, 196, 226, 255, 175, 181, 220, 255, 177, 182, 221, 255, 164, 171, 214, 255, 164, 168, 213, 255, 172, 178, 216, 255, 184, 192, 228, 255, 129, 142, 167, 255, 170, 178, 158, 255, 231, 234, 188, 255, 220, 223, 184, 255, 214, 220, 177, 255, 226, 232, 198, 255, 234, 239, 214, 255, 136, 147, 143, 255, 125, 137, 149, 255, 184, 193, 220, 255, 105, 107, 110, 255, 175, 175, 167, 255, 65, 69, 86, 255, 181, 190, 250, 255, 177, 185, 242, 255, 135, 143, 196, 255, 136, 142, 196, 255, 180, 188, 244, 255, 172, 180, 238, 255, 174, 181, 239, 255, 179, 185, 242, 255, 130, 138, 191, 255, 180, 194, 242, 255, 81, 94, 125, 255, 138, 144, 137, 255, 220, 209, 195, 255, 217, 207, 192, 255, 212, 202, 186, 255, 211, 200, 184, 255, 213, 200, 186, 255, 214, 201, 187, 255, 212, 201, 186, 255, 211, 200, 184, 255, 213, 205, 185, 255, 215, 208, 183, 255, 214, 208, 183, 255, 216, 209, 183, 255, 214, 209, 179, 255, 213, 211, 175, 255, 208, 207, 168, 255, 214, 214, 171, 255, 234, 232, 185, 255, 203, 204, 169, 255, 138, 144, 131, 255, 88, 96, 103, 255, 71, 80, 98, 255, 70, 81, 104, 255, 67, 77, 104, 255, 63, 72, 99, 255, 65, 72, 97, 255, 75, 82, 101, 255, 94, 98, 111, 255, 112, 119, 123, 255, 128, 138, 136, 255, 149, 158, 151, 255, 166, 176, 165, 255, 181, 188, 176, 255, 189, 194, 180, 255, 188, 192, 178, 255, 177, 182, 169, 255, 165, 169, 160, 255, 55, 62, 82, 255, 58, 69, 97, 255, 190, 203, 213, 255, 218, 238, 249, 255, 210, 237, 255, 255, 122, 141, 161, 255, 117, 121, 116, 255, 163, 168, 165, 255, 138, 145, 149, 255, 238, 248, 255, 255, 212, 223, 234, 255, 216, 227, 239, 255, 216, 225, 238, 255, 184, 193, 224, 255, 175, 183, 221, 255, 175, 181, 220, 255, 168, 174, 216, 255, 167, 176, 216, 255, 179, 191, 226, 255, 152, 162, 192, 255, 149, 157, 155, 255, 235, 238, 202, 255, 221, 224, 194, 255, 229, 233, 200, 255, 230, 236, 209, 255, 136, 144, 144, 255, 120, 130, 144, 255, 193, 204, 234, 255, 141, 149, 175, 255, 125, 126, 124, 255, 145, 147, 140, 255, 89, 95, 122, 255, 192, 203, 255, 255, 161, 169, 222, 255, 24, 28, 91, 255, 46, 49, 111, 255, 181, 189, 243, 255, 173, 181, 238, 255, 174, 182, 240, 255, 182, 190, 243, 255, 28, 33, 86, 255, 125, 134, 184, 255, 84, 96, 125, 255, 157, 159, 151, 255, 213, 204, 187, 255, 212, 201, 185, 255, 210, 198, 182, 255, 209, 198, 182, 255, 210, 197, 180, 255, 209, 196, 179, 255, 209, 197, 179, 255, 208, 198, 180, 255, 208, 203, 181, 255, 213, 210, 183, 255, 215, 210, 183, 255, 214, 209, 182, 255, 215, 209, 176, 255, 212, 206, 167, 255, 211, 207, 167, 255, 211, 207, 167, 255, 208, 204, 163, 255, 219, 214, 169, 255, 232, 228, 180, 255, 236, 231, 187, 255, 227, 221, 179, 255, 216, 211, 172, 255, 213, 208, 171, 255, 216, 211, 175, 255, 227, 222, 183, 255, 233, 230, 186, 255, 237, 233, 190, 255, 238, 234, 193, 255, 235, 236, 197, 255, 232, 235, 198, 255, 229, 234, 198, 255, 226, 232, 197, 255, 224, 228, 194, 255, 229, 233, 203, 255, 237, 242, 214, 255, 230, 232, 203, 255, 134, 135, 128, 255, 38, 44, 64, 255, 0, 5, 37, 255, 75, 87, 102, 255, 214, 234, 242, 255, 184, 207, 228, 255, 67, 71, 71, 255, 207, 211, 206, 255, 89, 95, 98, 255, 239, 246, 252, 255, 230, 237, 243, 255, 215, 225, 238, 255, 218, 227, 239, 255, 213, 222, 237, 255, 178, 187, 222, 255, 175, 183, 220, 255, 168, 178, 218, 255, 164, 174, 215, 255, 175, 187, 223, 255, 173, 183, 216, 255, 136, 141, 153, 255, 225, 228, 204, 255, 237, 240, 209, 255, 213, 217, 196, 255, 126, 135, 137, 255, 128, 138, 154, 255, 186, 193, 222, 255, 195, 203, 238, 255, 98, 104, 116, 255, 169, 174, 166, 255, 87, 91, 86, 255, 34, 46, 70, 255, 192, 204, 255, 255, 177, 187, 240, 255, 48, 54, 112, 255, 105, 111, 167, 255, 182, 191, 247, 255, 170, 178, 235, 255, 170, 177, 234, 255, 186, 195, 248, 255, 86, 92, 141, 255, 115, 122, 169, 255, 70, 78, 102, 255, 189, 194, 177, 255, 212, 201, 185, 255, 211, 200, 183, 255, 211, 197, 181, 255, 208, 196, 179, 255, 207, 194, 176, 255, 207, 194, 177, 255, 206, 195, 176, 255, 206, 197, 178, 255, 208, 202, 179, 255, 212, 210, 182, 255, 213, 210, 182, 255, 215, 210, 180, 255, 214, 208, 175, 255, 213, 206, 169, 255, 215, 206, 167, 255, 214, 208, 167, 255, 210, 205, 161, 255, 210, 205, 160, 255, 210, 206, 163, 255, 210, 207, 165, 255, 213, 209, 168, 255, 216, 210, 165, 255, 216, 212, 165, 255, 218, 215, 171, 255, 214, 211, 168, 255, 209, 207, 164, 255, 205, 207, 165, 255, 210, 210, 171, 255, 211, 213, 180, 255, 213, 216, 187, 255, 213, 216, 185, 255, 210, 216, 181, 255, 208, 216, 180, 255, 211, 217, 183, 255, 213, 219, 188, 255, 218, 223, 195, 255, 230, 233, 201, 255, 224, 227, 195, 255, 106, 109, 118, 255, 3, 16, 54, 255, 63, 77, 92, 255, 235, 255, 255, 255, 69, 79, 86, 255, 185, 186, 182, 255, 125, 130, 129, 255, 157, 163, 171, 255, 255, 255, 255, 255, 226, 232, 239, 255, 214, 224, 237, 255, 219, 228, 240, 255, 213, 223, 238, 255, 182, 192, 225, 255, 170, 186, 222, 255, 169, 182, 219, 255, 174, 185, 221, 255, 183, 194, 230, 255, 139, 146, 168, 255, 202, 207, 193, 255, 204, 209, 195, 255, 120, 126, 138, 255, 153, 161, 181, 255, 193, 200, 229, 255, 191, 196, 230, 255, 169, 174, 202, 255, 83, 90, 88, 255, 181, 190, 181, 255, 69, 75, 74, 255, 102, 119, 134, 255, 50, 63, 94, 255, 176, 187, 233, 255, 195, 203, 255, 255, 197, 206, 255, 255, 182, 193, 247, 255, 185, 194, 250, 255, 190, 199, 254, 255, 187, 195, 250, 255, 208, 215, 255, 255, 121, 131, 173, 255, 71, 78, 92, 255, 228, 233, 211, 255, 210, 199, 182, 255, 209, 197, 179, 255, 207, 193, 175, 255, 205, 193, 174, 255, 205, 193, 174, 255, 207, 194, 175, 255, 206, 194, 175, 255, 206, 196, 176, 255, 212, 205, 181, 255, 213, 209, 182, 255, 210, 210, 178, 255, 212, 210, 179, 255, 213, 209, 176, 255, 214, 207, 169, 255, 215, 205, 165, 255, 216, 204, 162, 255, 211, 203, 158, 255, 211, 202, 156, 255, 213, 207, 161, 255, 212, 207, 159, 255, 209, 205, 154, 255, 210, 204, 155, 255, 211, 205, 161, 255, 208, 207, 162, 255, 208, 207, 162, 255, 209, 206, 163, 255, 209, 210, 167, 255, 211, 214, 177, 255, 215, 217, 186, 255, 216, 218, 188, 255, 216, 217, 187, 255, 213, 216, 182, 255, 212, 218, 183, 255, 211, 218, 182, 255, 210, 216, 179, 255, 212, 216, 181, 255, 215, 221, 188, 255, 215, 222, 190, 255, 77, 84, 93, 255, 30, 47, 82, 255, 14, 31, 63, 255, 119, 134, 150, 255, 180, 200, 211, 255, 71, 72, 71, 255, 232, 235, 233, 255, 65, 72, 76, 255, 228, 233, 238, 255, 255, 255, 255, 255, 224, 230, 238, 255, 214, 224, 237, 255, 217, 230, 241, 255, 215, 226, 239, 255, 186, 201, 228, 255, 176, 189, 222, 255, 177, 187, 222, 255, 182, 193, 226, 255, 172, 182, 208, 255, 145, 153, 161, 255, 141, 148, 162, 255, 191, 197, 217, 255, 205, 211, 237, 255, 183, 189, 219, 255, 202, 209, 241, 255, 83, 88, 96, 255, 168, 178, 170, 255, 104, 108, 103, 255, 106, 123, 136, 255, 226, 254, 255, 255, 113, 131, 147, 255, 42, 55, 79, 255, 87, 98, 131, 255, 102, 114, 152, 255, 51, 66, 101, 255, 62, 75, 112, 255, 101, 111, 153, 255, 120, 130, 170, 255, 99, 108, 151, 255, 29, 40, 77, 255, 174, 181, 173, 255, 225, 230, 209, 255, 207, 192, 174, 255, 206, 191, 172, 255, 206, 192, 172, 255, 206, 192, 173, 255, 204, 193, 173, 255, 206, 194, 174, 255, 206, 195, 174, 255, 206, 195, 174, 255, 212, 205, 180, 255, 214, 209, 181, 255, 213, 209, 180, 255, 212, 210, 177, 255, 213, 209, 173, 255, 215, 209, 170, 255, 213, 206, 164, 255, 213, 203, 158, 255, 215, 203, 155, 255, 214, 201, 153, 255, 213, 202, 155, 255, 214, 205, 154, 255, 211, 203, 150, 255, 209, 203, 149, 255, 210, 204, 155, 255, 210, 206, 161, 255, 209, 206, 161, 255, 212, 210, 169, 255, 212, 215, 176, 255, 216, 220, 190, 255, 217, 219, 191, 255, 217, 219, 191, 255, 216, 217, 187, 255, 213, 216, 184, 255, 213, 217, 184, 255, 214, 218, 183, 255, 212, 215, 180, 255, 212, 216, 180, 255, 213, 219, 187, 255, 225, 233, 199, 255, 176, 183, 164, 255, 20, 32, 59, 255, 28, 44, 77, 255, 6, 18, 45, 255, 151, 166, 182, 255, 96, 104, 108, 255, 158, 157, 156, 255, 186, 189, 187, 255, 67, 79, 84, 255, 243, 251, 255, 255, 255, 255, 255, 255, 227, 233, 239, 255, 216, 226, 238, 255, 217, 229, 240, 255, 215, 226, 240, 255, 196, 206, 230, 255, 191, 197, 225, 255, 188, 198, 227, 255, 186, 198, 219, 255, 143, 151, 162, 255, 208, 214, 230, 255, 213, 220, 239, 255, 198, 204, 227, 255, 218, 226, 252, 255, 104, 116, 128, 255, 113, 123, 116, 255, 185, 189, 183, 255, 43, 47, 50, 255, 193, 216, 243, 255, 186, 211, 241, 255, 206, 232, 255, 255, 186, 205, 225, 255, 131, 146, 164, 255, 99, 116, 133, 255, 114, 132, 151, 255, 128, 145, 162, 255, 109, 126, 142, 255, 16, 27, 56, 255, 94, 101, 113, 255, 183, 189, 176, 255, 223, 229, 205, 255, 208, 213, 192, 255, 206, 190, 171, 255, 205, 191, 171, 255, 205, 192, 172, 255, 205, 193, 172, 255, 205, 194, 173, 255, 206, 195, 174, 255, 206, 194, 173, 255, 208, 196, 174, 255, 213, 206, 179, 255, 212, 207, 177, 255, 212, 208, 178, 255, 212, 209, 177, 255, 214, 208, 171, 255, 213, 207, 168, 255, 213, 206, 161, 255, 212, 204, 154, 255, 213, 205, 155, 255, 213, 205, 154, 255, 212, 204, 153, 255, 213, 203, 150, 255, 213, 202, 149, 255, 211, 203, 150, 255, 210, 204, 152, 255, 210, 204, 156, 255, 211, 206, 163, 255, 216, 213, 177, 255, 218, 218, 189, 255, 219, 222, 196, 255, 217, 221, 195, 255, 216, 220, 192, 255, 216, 218, 188, 255, 214, 216, 184, 255, 213, 217, 184, 255, 213, 216, 183, 255, 213, 216, 182, 255, 213, 217, 182, 255, 215, 219, 186, 255, 213, 219, 189, 255, 247, 251, 214, 255, 139, 145, 138, 255, 16, 30, 66, 255, 39, 57, 93, 255, 10, 18, 47, 255, 139, 149, 165, 255, 52, 56, 56, 255, 214, 215, 215, 255, 153, 160, 159, 255, 68, 83, 88, 255, 225, 235, 241, 255, 255, 255, 255, 255, 227, 235, 242, 255, 217, 226, 238, 255, 218, 226, 238, 255, 216, 224, 238, 255, 197, 203, 227, 255, 200, 209, 234, 255, 156, 166, 179, 255, 181, 187, 198, 255, 219, 227, 243, 255, 215, 222, 241, 255, 219, 230, 249, 255, 110, 123, 131, 255, 93, 106, 100, 255, 207, 217, 209, 255, 46, 47, 45, 255, 171, 188, 210, 255, 212, 234, 255, 255, 186, 209, 239, 255, 183, 208, 238, 255, 190, 216, 247, 255, 208, 232, 255, 255, 214, 234, 253, 255, 188, 209, 232, 255, 248, 255, 255, 255, 106, 122, 141, 255, 84, 97, 107, 255, 252, 254, 224, 255, 224, 229, 199, 255, 209, 215, 190, 255, 212, 215, 193, 255, 207, 190, 171, 255, 206, 190, 171, 255, 206, 191, 171, 255, 205, 192, 172, 255, 206, 194, 173, 255, 207, 195, 174, 255, 208, 195, 174, 255, 211, 200, 175, 255, 213, 206, 178, 255, 212, 208, 177, 255, 212, 208, 176, 255, 212, 207, 174, 255, 213, 206, 168, 255, 214, 206, 163, 255, 213, 204, 152, 255, 213, 204, 151, 255, 213, 203, 153, 255, 214, 204, 154, 255, 215, 204, 152, 255, 215, 203, 150, 255, 214, 203, 149, 255, 212, 204, 151, 255, 210, 205, 155, 255, 210, 204, 158, 255, 215, 211, 173, 255, 218, 217, 186, 255, 221, 220, 195, 255, 221, 221, 199, 255, 220, 221, 197, 255, 217, 220, 192, 255, 215, 218, 187, 255, 215, 216, 185, 255, 215, 216, 184, 255, 213, 216, 183, 255, 213, 218, 183, 255, 213, 219, 183, 255, 216, 221, 189, 255, 221, 223, 192, 255, 217, 221, 194, 255, 141, 149, 142, 255, 35, 48, 74, 255, 43, 61, 101, 255, 29, 45, 80, 255, 53, 62, 88, 255, 193, 209, 217, 255, 37, 39, 39, 255, 217, 221, 220, 255, 174, 179, 177, 255, 58, 70, 72, 255, 157, 173, 179, 255, 229, 240, 250, 255, 243, 247, 255, 255, 237, 237, 250, 255, 230, 233, 245, 255, 215, 223, 239, 255, 206, 213, 235, 255, 157, 164, 175, 255, 219, 226, 240, 255, 228, 239, 255, 255, 175, 190, 201, 255, 71, 84, 86, 255, 116, 129, 122, 255, 211, 222, 215, 255, 58, 61, 57, 255, 131, 144, 155, 255, 143, 158, 188, 255, 128, 145, 173, 255, 192, 214, 244, 255, 191, 215, 245, 255, 185, 209, 238, 255, 194, 215, 240, 255, 191, 210, 233, 255, 219, 238, 255, 255, 160, 175, 188, 255, 34, 46, 69, 255, 216, 223, 202, 255, 220, 224, 192, 255, 214, 216, 190, 255, 215, 217, 192, 255, 212, 214, 190, 255, 205, 189, 170, 255, 206, 190, 171, 255, 207, 192, 172, 255, 207, 191, 172, 255, 206, 193, 173, 255, 206, 195, 173, 255, 209, 198, 176, 255, 211, 200, 175, 255, 211, 205, 175, 255, 212, 207, 175, 255, 212, 207, 173, 255, 211, 206, 170, 255, 213, 205, 159, 255, 214, 205, 155, 255, 214, 204, 152, 255, 214, 204, 151, 255, 214, 205, 152, 255, 213, 205, 152, 255, 213, 205, 152, 255, 213, 204, 150, 255, 213, 204, 149, 255, 215, 205, 153, 255, 214, 206, 157, 255, 214, 207, 165, 255, 217, 213, 179, 255, 218, 218, 190, 255, 219, 219, 194, 255, 221, 221, 200, 255, 221, 221, 199, 255, 217, 219, 192, 255, 214, 217, 186, 255, 215, 216, 185, 255, 216, 217, 185, 255, 214, 217, 185, 255, 214, 219, 185, 255, 214, 219, 185, 255, 218, 224, 193, 255, 219, 220, 193, 255, 235, 236, 207, 255, 98, 105, 112, 255, 6, 20, 52, 255, 39, 58, 91, 255, 23, 42, 71, 255, 19, 32, 57, 255, 156, 170, 184, 255, 194, 206, 212, 255, 37, 39, 39, 255, 175, 176, 175, 255, 225, 229, 227, 255, 108, 113, 114, 255, 87, 94, 99, 255, 139, 148, 155, 255, 184, 191, 201, 255, 205, 214, 224, 255, 209, 222, 231, 255, 202, 212, 225, 255, 152, 159, 168, 255, 148, 156, 164, 255, 95, 106, 109, 255, 86, 94, 91, 255, 182, 190, 183, 255, 185, 190, 184, 255, 51, 53, 51, 255, 109, 119, 132, 255, 58, 70, 94, 255, 63, 69, 85, 255, 59, 69, 84, 255, 48, 64, 87, 255, 181, 203, 231, 255, 194, 217, 245, 255, 196, 216, 240, 255, 204, 223, 245, 255, 218, 234, 245, 255, 28, 44, 73, 255, 137, 145, 142, 255, 248, 248, 208, 255, 209, 212, 179, 255, 217, 215, 185, 255, 218, 216, 190, 255, 220, 220, 196, 255, 202, 190, 169, 255, 203, 190, 170, 255, 206, 191, 171, 255, 206, 191, 171, 255, 207, 192, 172, 255, 209, 198, 175, 255, 210, 202, 176, 255, 211, 204, 176, 255, 211, 206, 175, 255, 213, 207, 174, 255, 213, 206, 171, 255, 211, 204, 165, 255, 212, 204, 157, 255, 215, 203, 152, 255, 216, 204, 151, 255, 217, 204, 151, 255, 216, 204, 151, 255, 215, 205, 151, 255, 214, 204, 150, 255, 212, 204, 150, 255, 211, 204, 150, 255, 214, 205, 153, 255, 214, 206, 160, 255, 214, 207, 166, 255, 218, 213, 180, 255, 217, 217, 188, 255, 219, 219, 192, 255, 221, 221, 199, 255, 220, 222, 199, 255, 215, 218, 187, 255, 213, 216, 185, 255, 214, 216, 185, 255, 216, 217, 185, 255, 216, 218, 186, 255, 217, 218, 186, 255, 216, 218, 186, 255, 217, 223, 190, 255, 218, 221, 192, 255, 229, 229, 198, 255, 213, 215, 193, 255, 20, 30, 58, 255, 28, 49, 84, 255, 31, 54, 85, 255, 19, 37, 64, 255, 0, 0, 25, 255, 121, 129, 146, 255, 220, 233, 241, 255, 59, 64, 67, 255, 89, 88, 87, 255, 209, 207, 206, 255, 207, 206, 205, 255, 148, 150, 150, 255, 113, 117, 118, 255, 100, 106, 107, 255, 98, 107, 107, 255, 103, 109, 109, 255, 109, 113, 113, 255, 133, 138, 136, 255, 184, 191, 187, 255, 199, 203, 199, 255, 104, 104, 99, 255, 48, 52, 55, 255, 161, 177, 198, 255, 64, 73, 101, 255, 163, 166, 171, 255, 255, 255, 255, 255, 255, 255, 255, 255, 135, 142, 149, 255, 68, 83, 106, 255, 204, 228, 253, 255, 190, 215, 238, 255, 220, 243, 255, 255, 87, 104, 120, 255, 44, 56, 76, 255, 57, 64, 85, 255, 160, 160, 143, 255, 233, 228, 185, 255, 218, 209, 176, 255, 219, 216, 185, 255, 213, 215, 186, 255, 202, 189, 168, 255, 203, 191, 169, 255, 205, 192, 170, 255, 206, 191, 170, 255, 208, 194, 171, 255, 211, 199, 174, 255, 212, 203, 175, 255, 210, 206, 175, 255, 211, 207, 175, 255, 213, 206, 172, 255, 213, 204, 168, 255, 213, 203, 163, 255, 213, 203, 159, 255, 214, 203, 152, 255, 216, 205, 151, 255, 217, 204, 151, 255, 216, 204, 150, 255, 215, 203, 148, 255, 214, 203, 149, 255, 212, 204, 150, 255, 210, 205, 150, 255, 212, 206, 158, 255, 213, 206, 162, 255, 213, 207, 165, 255, 216, 212, 175, 255, 216, 213, 182, 255, 220, 218, 191, 255, 220, 220, 193, 255, 216, 220, 191, 255, 214, 217, 186, 255, 213, 217, 184, 255, 214, 217, 185, 255, 216, 217, 187, 255, 219, 219, 187, 255, 217, 217, 185, 255, 219, 217, 185, 255, 216, 218, 182, 255, 220, 223, 190, 255, 216, 217, 186, 255, 243, 243, 208, 255, 124, 131, 127, 255, 17, 37, 75, 255, 50, 74, 109, 255, 28, 50, 81, 255, 30, 43, 75, 255, 4, 10, 37, 255, 184, 197, 213, 255, 240, 255, 255, 255, 131, 145, 157, 255, 55, 61, 64, 255, 85, 84, 83, 255, 147, 146, 143, 255, 184, 184, 181, 255, 192, 194, 191, 255, 193, 196, 193, 255, 187, 192, 189, 255, 182, 186, 183, 255, 150, 153, 149, 255, 90, 91, 89, 255, 51, 55, 59, 255, 108, 119, 134, 255, 216, 233, 255, 255, 130, 143, 177, 255, 115, 115, 124, 255, 255, 255, 255, 255, 225, 227, 227, 255, 255, 255, 255, 255, 255, 255, 255, 255, 97, 107, 121, 255, 138, 164, 188, 255, 218, 246, 255, 255, 156, 179, 195, 255, 71, 84, 98, 255, 255, 255, 255, 255, 225, 225, 230, 255, 63, 62, 86, 255, 207, 198, 166, 255, 226, 218, 178, 255, 217, 212, 177, 255, 204, 203, 164, 255, 202, 189, 169, 255, 204, 192, 170, 255, 205, 193, 170, 255, 207, 194, 172, 255, 210, 197, 173, 255, 209, 197, 172, 255, 211, 202, 174, 255, 210, 205, 175, 255, 212, 205, 174, 255, 212, 203, 170, 255, 212, 202, 166, 255, 214, 202, 163, 255, 214, 202, 161, 255, 214, 202, 154, 255, 216, 204, 150, 255, 216, 205, 150, 255, 216, 204, 149, 255, 214, 202, 147, 255, 213, 202, 148, 255, 213, 203, 151, 255, 212, 205, 155, 255, 213, 206, 161, 255, 213, 207, 163, 255, 212, 206, 163, 255, 215, 209, 169, 255, 215, 210, 172, 255, 217, 214, 182, 255, 216, 217, 187, 255, 214, 218, 187, 255, 215, 218, 187, 255, 214, 217, 186, 255, 213, 218, 186, 255, 220, 221, 192, 255, 224, 220, 193, 255, 222, 218, 188, 255, 223, 218, 184, 255, 222, 218, 182, 255, 220, 219, 183, 255, 222, 221, 184, 255, 225, 224, 188, 255, 222, 225, 194, 255, 60, 71, 90, 255, 55, 78, 121, 255, 48, 72, 108, 255, 28, 45, 79, 255, 28, 41, 66, 255, 164, 182, 199, 255, 229, 246, 255, 255, 227, 243, 255, 255, 204, 227, 252, 255, 151, 171, 192, 255, 99, 114, 127, 255, 74, 86, 93, 255, 68, 77, 82, 255, 68, 74, 80, 255, 72, 81, 86, 255, 43, 55, 62, 255, 70, 84, 97, 255, 144, 163, 183, 255, 194, 215, 244, 255, 207, 226, 255, 255, 200, 220, 253, 255, 76, 86, 110, 255, 216, 216, 217, 255, 98, 102, 102, 255, 17, 21, 23, 255, 217, 218, 219, 255, 255, 255, 255, 255, 187, 193, 198, 255, 85, 109, 133, 255, 222, 253, 255, 255, 91, 112, 129, 255, 130, 135, 139, 255, 163, 163, 165, 255, 255, 255, 255, 255, 153, 154, 171, 255, 122, 122, 113, 255, 241, 233, 187, 255, 210, 206, 168, 255, 200, 199, 157, 255, 203, 187, 168, 255, 204, 191, 170, 255, 207, 195, 172, 255, 208, 196, 173, 255, 208, 197, 173, 255, 208, 198, 172, 255, 210, 201, 174, 255, 211, 204, 175, 255, 211, 204, 173, 255, 210, 201, 166, 255, 209, 200, 163, 255, 210, 198, 160, 255, 213, 200, 160, 255, 214, 201, 155, 255, 216, 203, 149, 255, 215, 203, 147, 255, 215, 203, 148, 255, 215, 202, 148, 255, 213, 202, 148, 255, 213, 205, 157, 255, 214, 208, 163, 255, 212, 207, 163, 255, 212, 208, 164, 255, 212, 208, 165, 255, 213, 208, 165, 255, 216, 211, 172, 255, 217, 214, 179, 255, 217, 216, 185, 255, 216, 219, 188, 255, 215, 218, 187, 255, 217, 219, 191, 255, 219, 222, 194, 255, 223, 224, 197, 255, 224, 223, 197, 255, 226, 222, 195, 255, 224, 219, 188, 255, 222, 216, 175, 255, 221, 219, 174, 255, 218, 217, 176, 255, 230, 230, 191, 255, 188, 190, 167, 255, 32, 42, 71, 255, 63, 83, 123, 255, 39, 57, 93, 255, 29, 46, 80, 255, 29, 48, 80, 255, 33, 49, 75, 255, 106, 121, 140, 255, 216, 234, 246, 255, 209, 229, 244, 255, 196, 223, 252, 255, 203, 232, 255, 255, 202, 232, 255, 255, 196, 225, 254, 255, 192, 219, 248, 255, 204, 233, 255, 255, 157, 188, 218, 255, 147, 176, 210, 255, 211, 237, 255, 255, 186, 211, 242, 255, 187, 211, 241, 255, 180, 205, 236, 255, 77, 89, 107, 255, 246, 247, 246, 255, 91, 92, 94, 255, 36, 38, 41, 255, 208, 208, 209, 255, 255, 255, 255, 255, 232, 235, 236, 255, 81, 103, 125, 255, 194, 223, 246, 255, 95, 111, 127, 255, 95, 99, 101, 255, 12, 16, 20, 255, 239, 239, 238, 255, 217, 220, 228, 255, 94, 99, 103, 255, 233, 226, 182, 255, 211, 206, 167, 255, 200, 198, 157, 255, 202, 187, 166, 255, 206, 191, 169, 255, 209, 195, 172, 255, 209, 196, 173, 255, 209, 197, 173, 255, 209, 198, 173, 255, 210, 201, 174, 255, 211, 203, 176, 255, 211, 203, 173, 255, 209, 199, 164, 255, 207, 198, 161, 255, 209, 198, 161, 255, 209, 198, 160, 255, 212, 199, 155, 255, 213, 199, 146, 255, 213, 200, 145, 255, 213, 201, 147, 255, 214, 200, 147, 255, 213, 200, 150, 255, 214, 206, 162, 255, 214, 209, 166, 255, 213, 208, 166, 255, 212, 208, 165, 255, 212, 208, 167, 255, 211, 208, 165, 255, 216, 211, 172, 255, 218, 216, 184, 255, 218, 217, 186, 255, 220, 217, 189, 255, 219, 218, 191, 255, 219, 220, 193, 255, 221, 222, 195, 255, 223, 223, 196, 255, 221, 222, 193, 255, 222, 222, 192, 255, 224, 221, 191, 255, 223, 216, 173, 255, 225, 217, 175, 255, 222, 220, 182, 255, 224, 219, 188, 255, 239, 237, 203, 255, 94, 98, 105, 255, 20, 38, 82, 255, 56, 77, 116, 255, 33, 54, 88, 255, 32, 53, 87, 255, 31, 52, 87, 255, 3, 18, 46, 255, 110, 129, 149, 255, 227, 245, 255, 255, 205, 225, 240, 255, 183, 208, 237, 255, 185, 209, 240, 255, 186, 211, 242, 255, 184, 212, 242, 255, 185, 212, 243, 255, 182, 206, 237, 255, 155, 180, 213, 255, 189, 215, 243, 255, 186, 214, 242, 255, 191, 219, 248, 255, 165, 195, 224, 255, 86, 100, 115, 255, 255, 255, 255, 255, 232, 231, 232, 255, 208, 208, 208, 255, 255, 255, 255, 255, 255, 254, 255, 255, 249, 250, 250, 255, 91, 110, 132, 255, 176, 202, 227, 255, 102, 114, 130, 255, 219, 219, 219, 255, 183, 183, 185, 255, 255, 255, 255, 255, 228, 235, 239, 255, 92, 99, 108, 255, 223, 218, 176, 255, 210, 206, 166, 255, 201, 197, 157, 255, 204, 189, 166, 255, 208, 193, 171, 255, 210, 194, 173, 255, 210, 196, 173, 255, 208, 198, 172, 255, 210, 199, 174, 255, 210, 202, 176, 255, 211, 202, 176, 255, 211, 202, 175, 255, 210, 199, 165, 255, 208, 198, 161, 255, 209, 198, 162, 255, 209, 198, 161, 255, 209, 197, 155, 255, 210, 197, 149, 255, 210, 197, 144, 255, 211, 199, 146, 255, 213, 201, 149, 255, 213, 202, 156, 255, 212, 205, 163, 255, 213, 208, 166, 255, 213, 208, 166, 255, 214, 209, 168, 255, 214, 210, 169, 255, 213, 209, 168, 255, 218, 212, 177, 255, 221, 217, 189, 255, 218, 217, 189, 255, 221, 217, 191, 255, 221, 218, 192, 255, 222, 220, 194, 255, 222, 221, 194, 255, 224, 222, 193, 255, 223, 220, 192, 255, 220, 218, 184, 255, 221, 217, 178, 255, 223, 215, 173, 255, 223, 215, 169, 255, 225, 221, 186, 255, 228, 221, 196, 255, 231, 223, 191, 255, 234, 228, 195, 255, 82, 87, 98, 255, 36, 52, 92, 255, 56, 80, 120, 255, 36, 59, 94, 255, 40, 62, 98, 255, 15, 35, 65, 255, 79, 98, 119, 255, 202, 226, 247, 255, 219, 240, 251, 255, 207, 226, 244, 255, 187, 208, 240, 255, 190, 212, 244, 255, 188, 213, 244, 255, 190, 213, 243, 255, 190, 213, 244, 255, 187, 213, 244, 255, 186, 214, 243, 255, 186, 216, 243, 255, 188, 221, 248, 255, 163, 195, 224, 255, 89, 105, 118, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 253, 253, 253, 255, 255, 255, 255, 255, 251, 250, 250, 255, 96, 110, 134, 255, 172, 198, 225, 255, 102, 115, 130, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 229, 235, 239, 255, 95, 99, 108, 255, 221, 216, 174, 255, 209, 205, 165, 255, 202, 198, 159, 255, 209, 194, 168, 255, 210, 196, 172, 255, 210, 196, 174, 255, 209, 195, 173, 255, 209, 196, 173, 255, 211, 198, 173, 255, 210, 200, 174, 255, 211, 201, 175, 255, 211, 201, 174, 255, 210, 197, 165, 255, 210, 198, 162, 255, 209, 198, 162, 255, 209, 198, 160, 255, 209, 196, 154, 255, 208, 196, 147, 255, 209, 197, 144, 255, 211, 200, 150, 255, 213, 202, 153, 255, 213, 204, 160, 255, 215, 207, 165, 255, 213, 207, 165, 255, 215, 209, 168, 255, 215, 209, 170, 255, 216, 210, 171, 255, 217, 212, 175, 255, 221, 214, 184, 255, 221, 215, 188, 255, 221, 217, 193, 255, 222, 220, 195, 255, 220, 217, 189, 255, 221, 216, 188, 255, 226, 220, 195, 255, 224, 219, 192, 255, 221, 218, 187, 255, 220, 217, 180, 255, 219, 215, 170, 255, 222, 216, 171, 255, 223, 216, 172, 255, 225, 220, 185, 255, 227, 222, 193, 255, 224, 217, 188, 255, 239, 233, 188, 255, 170, 170, 154, 255, 2, 12, 47, 255, 46, 59, 95, 255, 51, 70, 110, 255, 44, 64, 103, 255, 36, 55, 90, 255, 16, 32, 59, 255, 48, 65, 87, 255, 176, 201, 219, 255, 216, 237, 249, 255, 199, 220, 240, 255, 187, 210, 241, 255, 190, 213, 244, 255, 191, 213, 243, 255, 190, 213, 244, 255, 187, 213, 243, 255, 186, 215, 244, 255, 185, 216, 243, 255, 187, 216, 245, 255, 176, 203, 234, 255, 85, 98, 114, 255, 248, 249, 249, 255, 255, 255, 255, 255, 252, 252, 252, 255, 254, 254, 254, 255, 255, 255, 255, 255, 238, 238, 239, 255, 86, 100, 125, 255, 189, 214, 242, 255, 92, 106, 124, 255, 244, 245, 244, 255, 249, 252, 252, 255, 255, 255, 255, 255, 212, 215, 222, 255, 95, 99, 103, 255, 230, 223, 179, 255, 207, 204, 163, 255, 202, 199, 160, 255, 211, 195, 168, 255, 212, 198, 172, 255, 212, 199, 175, 255, 208, 196, 173, 255, 209, 197, 173, 255, 208, 197, 172, 255, 208, 197, 171, 255, 209, 200, 172, 255, 212, 202, 176, 255, 211, 199, 171, 255, 212, 198, 167, 255, 211, 200, 165, 255, 210, 199, 163, 255, 209, 196, 153, 255, 208, 196, 145, 255, 207, 197, 145, 255, 211, 201, 152, 255, 211, 201, 152, 255, 218, 208, 167, 255, 220, 212, 174, 255, 213, 207, 165, 255, 215, 209, 169, 255, 218, 212, 175, 255, 220, 213, 178, 255, 221, 214, 182, 255, 221, 213, 184, 255, 225, 218, 195, 255, 226, 221, 200, 255, 222, 217, 188, 255, 218, 209, 165, 255, 221, 213, 175, 255, 224, 214, 179, 255, 225, 215, 185, 255, 222, 212, 176, 255, 223, 215, 173, 255, 222, 215, 172, 255, 222, 216, 172, 255, 224, 216, 172, 255, 222, 217, 175, 255, 225, 221, 187, 255, 223, 216, 179, 255, 227, 222, 176, 255, 192, 190, 159, 255, 21, 29, 62, 255, 51, 70, 109, 255, 41, 61, 101, 255, 39, 58, 95, 255, 41, 61, 97, 255, 36, 52, 86, 255, 3, 15, 45, 255, 79, 96, 123, 255, 218, 244, 255, 255, 220, 241, 255, 255, 195, 219, 241, 255, 187, 212, 242, 255, 189, 215, 244, 255, 188, 214, 245, 255, 188, 214, 245, 255, 188, 214, 245, 255, 187, 215, 244, 255, 186, 211, 242, 255, 194, 220, 252, 255, 79, 95, 116, 255, 215, 219, 220, 255, 255, 255, 255, 255, 252, 253, 254, 255, 251, 252, 253, 255, 255, 255, 255, 255, 196, 197, 202, 255, 80, 96, 124, 255, 218, 245, 255, 255, 83, 99, 120, 255, 211, 213, 215, 255, 255, 255, 255, 255, 255, 255, 255, 255, 157, 159, 173, 255, 123, 124, 117, 255, 241, 234, 191, 255, 204, 202, 163, 255, 202, 201, 160, 255, 214, 197, 168, 255, 213, 199, 172, 255, 212, 201, 175, 255, 210, 199, 174, 255, 210, 198, 173, 255, 211, 197, 172, 255, 209, 196, 167, 255, 208, 197, 168, 255, 209, 199, 172, 255, 211, 201, 174, 255, 212, 200, 171, 255, 211, 201, 170, 255, 211, 199, 166, 255, 210, 197, 160, 255, 208, 196, 151, 255, 210, 199, 153, 255, 211, 202, 155, 255, 213, 205, 161, 255, 218, 208, 170, 255, 220, 211, 175, 255, 217, 208, 169, 255, 218, 211, 173, 255, 221, 214, 179, 255, 220, 212, 178, 255, 223, 214, 182, 255, 222, 214, 183, 255, 225, 218, 194, 255, 227, 219, 195, 255, 221, 211, 171, 255, 219, 209, 162, 255, 222, 211, 165, 255, 224, 212, 170, 255, 224, 212, 172, 255, 223, 211, 171, 255, 225, 213, 172, 255, 223, 214, 171, 255, 222, 215, 171, 255, 222, 214, 169, 255, 221, 215, 169, 255, 220, 216, 170, 255, 220, 215, 168, 255, 217, 211, 166, 255, 243, 237, 192, 255, 137, 135, 125, 255, 39, 54, 96, 255, 95, 118, 167, 255, 53, 74, 114, 255, 34, 52, 87, 255, 30, 49, 80, 255, 17, 36, 63, 255, 35, 50, 75, 255, 95, 112, 131, 255, 153, 176, 193, 255, 214, 238, 255, 255, 194, 215, 243, 255, 189, 212, 242, 255, 190, 214, 244, 255, 189, 214, 245, 255, 188, 214, 245, 255, 188, 214, 245, 255, 185, 211, 240, 255, 199, 227, 255, 255, 110, 132, 157, 255, 131, 136, 144, 255, 255, 255, 255, 255, 245, 248, 249, 255, 249, 251, 251, 255, 255, 255, 255, 255, 100, 105, 121, 255, 125, 144, 174, 255, 213, 238, 255, 255, 139, 158, 184, 255, 89, 94, 107, 255, 255, 255, 255, 255, 217, 218, 225, 255, 59, 66, 86, 255, 203, 202, 167, 255, 225, 217, 177, 255, 206, 202, 163, 255, 202, 202, 161, 255, 213, 198, 169, 255, 213, 199, 171, 255, 215, 202, 176, 255, 215, 202, 177, 255, 213, 199, 174, 255, 210, 197, 170, 255, 206, 194, 163, 255, 206, 195, 164, 255, 207, 198, 171, 255, 210, 201, 174, 255, 212, 203, 175, 255, 211, 203, 173, 255, 210, 201, 168, 255, 211, 199, 164, 255, 212, 201, 166, 255, 211, 204, 168, 255, 217, 210, 175, 255, 217, 209, 173, 255, 222, 215, 185, 255, 222, 213, 182, 255, 218, 209, 171, 255, 218, 210, 174, 255, 219, 212, 178, 255, 220, 214, 182, 255, 220, 214, 182, 255, 222, 215, 183, 255, 223, 216, 182, 255, 225, 214, 178, 255, 222, 210, 167, 255, 223, 210, 168, 255, 224, 213, 172, 255, 226, 212, 173, 255, 224, 209, 170, 255, 225, 210, 171, 255, 226, 212, 173, 255, 225, 213, 172, 255, 223, 215, 171, 255, 222, 214, 170, 255, 223, 214, 168, 255, 220, 214, 167, 255, 219, 215, 166, 255, 222, 215, 167, 255, 218, 212, 168, 255, 243, 239, 194, 255, 136, 136, 125, 255, 54, 66, 100, 255, 76, 95, 139, 255, 57, 78, 119, 255, 51, 72, 112, 255, 38, 57, 91, 255, 28, 44, 77, 255, 12, 25, 55, 255, 3, 17, 41, 255, 88, 108, 128, 255, 201, 227, 249, 255, 189, 215, 242, 255, 187, 211, 240, 255, 190, 213, 242, 255, 190, 214, 244, 255, 190, 214, 244, 255, 188, 213, 243, 255, 184, 213, 243, 255, 194, 221, 248, 255, 55, 66, 85, 255, 183, 188, 192, 255, 255, 255, 255, 255, 255, 255, 255, 255, 153, 158, 165, 255, 56, 70, 99, 255, 193, 220, 249, 255, 193, 217, 247, 255, 202, 224, 253, 255, 124, 141, 160, 255, 62, 74, 96, 255, 55, 63, 85, 255, 167, 168, 147, 255, 229, 226, 182, 255, 210, 203, 164, 255, 208, 203, 163, 255, 204, 201, 161, 255, 214, 198, 169, 255, 213, 197, 169, 255, 215, 200, 174, 255, 213, 199, 173, 255, 214, 198, 171, 255, 211, 196, 165, 255, 205, 192, 157, 255, 204, 192, 158, 255, 207, 198, 166, 255, 208, 200, 171, 255, 211, 203, 175, 255, 212, 203, 175, 255, 210, 200, 169, 255, 210, 200, 167, 255, 213, 205, 175, 255, 217, 211, 185, 255, 219, 214, 189, 255, 222, 217, 192, 255, 225, 218, 194, 255, 225, 217, 194, 255, 221, 213, 185, 255, 217, 211, 177, 255, 217, 210, 177, 255, 220, 214, 183, 255, 219, 214, 182, 255, 223, 215, 183, 255, 223, 215, 180, 255, 223, 214, 177, 255, 225, 213, 174, 255, 225, 210, 170, 255, 225, 211, 172, 255, 225, 211, 172, 255, 224, 209, 170, 255, 225, 209, 170, 255, 227, 211, 173, 255, 226, 211, 171, 255, 223, 213, 169, 255, 222, 214, 168, 255, 222, 212, 167, 255, 221, 212, 165, 255, 221, 213, 166, 255, 220, 213, 164, 255, 220, 213, 165, 255, 216, 213, 167, 255, 216, 216, 184, 255, 100, 101, 109, 255, 19, 28, 61, 255, 37, 49, 81, 255, 32, 49, 81, 255, 34, 52, 85, 255, 34, 53, 86, 255, 38, 55, 89, 255, 35, 49, 83, 255, 1, 13, 41, 255, 129, 150, 178, 255, 203, 230, 255, 255, 198, 225, 254, 255, 190, 216, 246, 255, 188, 211, 241, 255, 187, 210, 241, 255, 183, 209, 241, 255, 183, 210, 240, 255, 165, 191, 217, 255, 174, 193, 220, 255, 50, 61, 81, 255, 85, 94, 106, 255, 82, 90, 104, 255, 60, 74, 99, 255, 182, 206, 236, 255, 190, 215, 239, 255, 161, 184, 207, 255, 187, 215, 240, 255, 185, 206, 240, 255, 73, 83, 105, 255, 219, 215, 179, 255, 241, 232, 189, 255, 211, 204, 166, 255, 212, 204, 166, 255, 209, 204, 163, 255, 206, 200, 160, 255, 215, 198, 169, 255, 214, 198, 170, 255, 213, 198, 170, 255, 212, 196, 168, 255, 212, 196, 167, 255, 212, 197, 165, 255, 206, 194, 157, 255, 204, 192, 155, 255, 205, 195, 158, 255, 205, 196, 161, 255, 209, 202, 170, 255, 212, 202, 172, 255, 211, 200, 170, 255, 210, 202, 170, 255, 215, 210, 182, 255, 219, 214, 189, 255, 219, 213, 188, 255, 222, 216, 192, 255, 220, 215, 189, 255, 218, 213, 186, 255, 216, 212, 182, 255, 216, 211, 180, 255, 216, 210, 179, 255, 217, 214, 185, 255, 220, 217, 187, 255, 224, 216, 187, 255, 224, 215, 185, 255, 224, 216, 182, 255, 223, 211, 175, 255, 224, 208, 169, 255, 226, 210, 171, 255, 225, 209, 171, 255, 224, 209, 170, 255, 223, 208, 169, 255, 225, 210, 171, 255, 225, 210, 170, 255, 223, 211, 169, 255, 222, 213, 168, 255, 219, 211, 165, 255, 219, 211, 163, 255, 219, 211, 161, 255, 215, 209, 158, 255, 225, 217, 166, 255, 199, 195, 156, 255, 88, 93, 94, 255, 47, 54, 70, 255, 13, 23, 58, 255, 42, 56, 96, 255, 51, 66, 103, 255, 34, 50, 85, 255, 29, 48, 80, 255, 29, 47, 79, 255, 30, 46, 77, 255, 19, 36, 63, 255, 23, 40, 65, 255, 44, 61, 87, 255, 87, 106, 130, 255, 177, 201, 226, 255, 197, 221, 252, 255, 192, 215, 247, 255, 181, 201, 236, 255, 198, 221, 253, 255, 64, 79, 106, 255, 100, 114, 145, 255, 203, 221, 252, 255, 142, 160, 192, 255, 115, 132, 161, 255, 141, 162, 191, 255, 109, 128, 154, 255, 25, 41, 64, 255, 64, 85, 113, 255, 137, 164, 209, 255, 53, 72, 113, 255, 50, 57, 72, 255, 166, 164, 144, 255, 208, 202, 168, 255, 234, 225, 181, 255, 221, 213, 170, 255, 207, 201, 161, 255, 207, 200, 159, 255, 216, 197, 169, 255, 214, 198, 169, 255, 211, 196, 166, 255, 210, 195, 164, 255, 210, 193, 164, 255, 210, 194, 160, 255, 208, 194, 157, 255, 206, 194, 155, 255, 205, 195, 157, 255, 206, 196, 160, 255, 209, 201, 166, 255, 211, 203, 171, 255, 212, 201, 170, 255, 212, 203, 174, 255, 217, 212, 184, 255, 218, 215, 189, 255, 220, 215, 189, 255, 219, 213, 187, 255, 217, 212, 184, 255, 216, 212, 183, 255, 215, 212, 181, 255, 215, 212, 181, 255, 216, 211, 181, 255, 219, 216, 189, 255, 220, 217, 191, 255, 224, 217, 190, 255, 226, 215, 188, 255, 227, 216, 188, 255, 221, 209, 173, 255, 222, 207, 170, 255, 225, 210, 173, 255, 224, 209, 170, 255, 223, 207, 169, 255, 223, 206, 168, 255, 222, 208, 167, 255, 224, 209, 170, 255, 226, 211, 171, 255, 225, 211, 169, 255, 221, 208, 164, 255, 219, 207, 160, 255, 218, 208, 158, 255, 217, 208, 157, 255, 218, 209, 159, 255, 221, 212, 164, 255, 233, 225, 177, 255, 228, 225, 179, 255, 160, 159, 140, 255, 42, 50, 77, 255, 58, 74, 120, 255, 70, 86, 133, 255, 56, 73, 115, 255, 47, 64, 103, 255, 27, 41, 72, 255, 15, 32, 58, 255, 22, 43, 73, 255, 22, 44, 76, 255, 0, 20, 48, 255, 35, 51, 71, 255, 173, 194, 217, 255, 143, 164, 192, 255, 189, 209, 241, 255, 197, 215, 247, 255, 171, 189, 217, 255, 15, 25, 49, 255, 53, 63, 94, 255, 93, 105, 137, 255, 30, 41, 67, 255, 7, 22, 48, 255, 17, 36, 67, 255, 15, 35, 62, 255, 55, 77, 112, 255, 27, 47, 77, 255, 13, 30, 54, 255, 23, 39, 71, 255, 11, 26, 60, 255, 11, 19, 50, 255, 61, 61, 76, 255, 178, 174, 147, 255, 215, 208, 166, 255, 205, 196, 156, 255, 215, 195, 165, 255, 213, 195, 164, 255, 211, 194, 162, 255, 210, 192, 161, 255, 210, 193, 160, 255, 208, 192, 155, 255, 207, 194, 155, 255, 208, 195, 155, 255, 207, 197, 156, 255, 207, 198, 157, 255, 209, 201, 164, 255, 210, 201, 164, 255, 212, 202, 168, 255, 213, 205, 173, 255, 218, 213, 186, 255, 218, 216, 188, 255, 218, 216, 188, 255, 215, 213, 185, 255, 215, 214, 185, 255, 216, 214, 185, 255, 215, 213, 184, 255, 215, 213, 183, 255, 217, 214, 186, 255, 220, 216, 192, 255, 223, 218, 195, 255, 225, 217, 195, 255, 227, 217, 194, 255, 229, 217, 192, 255, 223, 210, 178, 255, 222, 209, 173, 255, 225, 210, 175, 255, 223, 209, 172, 255, 222, 208, 168, 255, 222, 207, 168, 255, 220, 206, 166, 255, 225, 210, 171, 255, 226, 210, 171, 255, 224, 210, 168, 255, 223, 209, 165, 255, 220, 208, 161, 255, 218, 207, 157, 255, 215, 208, 155, 255, 216, 207, 155, 255, 219, 206, 157, 255, 221, 208, 163, 255, 222, 213, 171, 255, 242, 234, 186, 255, 194, 187, 157, 255, 34, 40, 64, 255, 60, 73, 115, 255, 61, 77, 120, 255, 35, 50, 87, 255, 30, 47, 82, 255, 45, 65, 104, 255, 50, 72, 112, 255, 35, 54, 88, 255, 33, 51, 84, 255, 15, 31, 61, 255, 19, 34, 55, 255, 119, 138, 156, 255, 169, 192, 233, 255, 139, 152, 208, 255, 188, 207, 236, 255, 149, 163, 187, 255, 25, 31, 56, 255, 4, 13, 39, 255, 25, 38, 69, 255, 36, 55, 89, 255, 36, 59, 93, 255, 24, 45, 73, 255, 22, 38, 66, 255, 37, 55, 91, 255, 57, 82, 122, 255, 36, 55, 90, 255, 25, 36, 64, 255, 107, 105, 109, 255, 131, 126, 120, 255, 170, 166, 144, 255, 216, 208, 166, 255, 204, 198, 156, 255, 212, 191, 160, 255, 210, 189, 157, 255, 212, 191, 157, 255, 209, 190, 154, 255, 210, 191, 154, 255, 209, 191, 152, 255, 208, 192, 151, 255, 208, 193, 152, 255, 208, 194, 152, 255, 208, 196, 153, 255, 209, 198, 157, 255, 208, 198, 157, 255, 206, 197, 155, 255, 211, 203, 167, 255, 218, 212, 184, 255, 219, 215, 187, 255, 215, 214, 185, 255, 215, 213, 186, 255, 216, 214, 186, 255, 216, 215, 187, 255, 218, 216, 189, 255, 217, 215, 188, 255, 219, 216, 191, 255, 222, 218, 195, 255, 223, 218, 196, 255, 224, 217, 195, 255, 230, 219, 199, 255, 227, 214, 190, 255, 224, 211, 178, 255, 222, 210, 174, 255, 224, 210, 175, 255, 223, 209, 173, 255, 222, 208, 169, 255, 222, 207, 167, 255, 220, 205, 166, 255, 223, 209, 170, 255, 225, 210, 170, 255, 226, 209, 168, 255, 224, 208, 165, 255, 220, 205, 158, 255, 218, 206, 156, 255, 217, 205, 155, 255, 215, 204, 153, 255, 217, 207, 155, 255, 220, 209, 161, 255, 222, 212, 171, 255, 221, 214, 172, 255, 214, 208, 170, 255, 56, 63, 83, 255, 59, 77, 122, 255, 99, 116, 166, 255, 79, 98, 145, 255, 61, 83, 127, 255, 52, 73, 114, 255, 34, 51, 85, 255, 25, 39, 69, 255, 25, 39, 68, 255, 26, 42, 71, 255, 5, 22, 47, 255, 166, 192, 208, 255, 124, 143, 199, 255, 68, 75, 166, 255, 145, 156, 196, 255, 67, 81, 101, 255, 28, 42, 70, 255, 39, 56, 94, 255, 35, 53, 87, 255, 47, 67, 105, 255, 45, 66, 106, 255, 40, 57, 94, 255, 46, 61, 103, 255, 71, 91, 136, 255, 22, 41, 73, 255, 50, 62, 99, 255, 73, 85, 114, 255, 197, 192, 160, 255, 248, 238, 189, 255, 218, 210, 168, 255, 207, 201, 160, 255, 205, 200, 158, 255, 208, 189, 156, 255, 207, 186, 153, 255, 209, 189, 154, 255, 210, 188, 152, 255, 208, 188, 149, 255, 206, 188, 146, 255, 207, 191, 148, 255, 208, 192, 149, 255, 207, 192, 149, 255, 208, 193, 150, 255, 208, 194, 150, 255, 207, 195, 151, 255, 204, 194, 150, 255, 208, 200, 159, 255, 215, 211, 179, 255, 217, 214, 184, 255, 217, 214, 185, 255, 216, 214, 187, 255, 217, 215, 188, 255, 219, 216, 191, 255, 219, 216, 192, 255, 220, 216, 192, 255, 221, 218, 195, 255, 223, 219, 197, 255, 224, 218, 197, 255, 226, 219, 198, 255, 228, 218, 196, 255, 227, 215, 191, 255, 221, 209, 175, 255, 223, 209, 174, 255, 224, 209, 175, 255, 223, 208, 171, 255, 222, 209, 171, 255, 223, 208, 171, 255, 222, 208, 169, 255, 221, 209, 169, 255, 225, 211, 171, 255, 226, 211, 170, 255, 224, 207, 165, 255, 220, 203, 157, 255, 219, 202, 155, 255, 220, 202, 155, 255, 218, 204, 155, 255, 219, 207, 157, 255, 221, 209, 162, 255, 224, 210, 168, 255, 223, 212, 173, 255, 228, 222, 181, 255, 192, 193, 164, 255, 82, 89, 94, 255, 41, 51, 77, 255, 47, 61, 91, 255, 43, 55, 90, 255, 45, 55, 96, 255, 43, 52, 92, 255, 42, 49, 86, 255, 35, 44, 77, 255, 29, 43, 74, 255, 19, 38, 62, 255, 167, 196, 212, 255, 107, 128, 191, 255, 61, 74, 162, 255, 122, 135, 203, 255, 96, 111, 124, 255, 19, 38, 73, 255, 44, 63, 101, 255, 39, 56, 93, 255, 55, 69, 113, 255, 52, 67, 110, 255, 45, 58, 101, 255, 90, 108, 150, 255, 48, 62, 92, 255, 61, 71, 99, 255, 105, 115, 204, 255, 98, 116, 162, 255, 116, 124, 111, 255, 226, 220, 177, 255, 203, 198, 160, 255, 206, 200, 161, 255, 204, 199, 157, 255, 208, 188, 156, 255, 205, 185, 152, 255, 207, 187, 151, 255, 206, 185, 149, 255, 205, 186, 148, 255, 207, 187, 147, 255, 207, 188, 146, 255, 207, 190, 147, 255, 205, 189, 145, 255, 205, 190, 146, 255, 205, 190, 145, 255, 206, 192, 148, 255, 204, 194, 148, 255, 206, 198, 155, 255, 212, 205, 169, 255, 214, 210, 179, 255, 214, 212, 183, 255, 218, 216, 189, 255, 219, 215, 190, 255, 218, 216, 192, 255, 220, 217, 194, 255, 220, 217, 194, 255, 222, 218, 196, 255, 224, 219, 198, 255, 225, 219, 198, 255, 227, 218, 197, 255, 226, 217, 193, 255, 226, 215, 190, 255, 220, 205, 172, 255, 223, 208, 174, 255, 225, 208, 175, 255, 223, 207, 172, 255, 223, 209, 173, 255, 224, 209, 172, 255, 224, 209, 172, 255, 222, 210, 170, 255, 222, 213, 170, 255, 224, 212, 170, 255, 223, 207, 165, 255, 221, 204, 160, 255, 221, 201, 156, 255, 220, 201, 154, 255, 219, 202, 155, 255, 220, 204, 157, 255, 221, 206, 158, 255, 223, 207, 164, 255, 227, 212, 173, 255, 222, 212, 172, 255, 228, 223, 182, 255, 243, 241, 194, 255, 177, 177, 152, 255, 12, 25, 57, 255, 76, 93, 135, 255, 81, 98, 145, 255, 71, 85, 131, 255, 60, 73, 118, 255, 46, 57, 95, 255, 41, 52, 87, 255, 15, 28, 56, 255, 162, 186, 196, 255, 118, 138, 198, 255, 74, 88, 175, 255, 89, 107, 187, 255, 146, 167, 187, 255, 21, 42, 70, 255, 46, 66, 106, 255, 44, 62, 102, 255, 47, 60, 103, 255, 65, 77, 119, 255, 121, 139, 180, 255, 62, 78, 111, 255, 8, 17, 46, 255, 168, 181, 197, 255, 148, 160, 222, 255, 86, 106, 164, 255, 115, 127, 115, 255, 225, 224, 180, 255, 205, 201, 163, 255, 202, 198, 157, 255, 205, 200, 161, 255, 207, 187, 155, 255, 205, 186, 152, 255, 205, 186, 150, 255, 205, 185, 149, 255, 205, 186, 149, 255, 206, 187, 149, 255, 208, 188, 148, 255, 205, 186, 145, 255, 204, 186, 144, 255, 204, 187, 144, 255, 206, 190, 146, 255, 205, 191, 147, 255, 204, 191, 147, 255, 206, 196, 153, 255, 205, 198, 156, 255, 209, 204, 170, 255, 220, 218, 192, 255, 221, 218, 194, 255, 219, 216, 192, 255, 220, 218, 195, 255, 221, 219, 197, 255, 222, 219, 197, 255, 223, 219, 198, 255, 224, 219, 198, 255, 225, 218, 198, 255, 227, 218, 198, 255, 227, 218, 197, 255, 224, 214, 185, 255, 222, 208, 176, 255, 223, 207, 175, 255, 225, 207, 175, 255, 225, 209, 175, 255, 225, 209, 173, 255, 225, 210, 173, 255, 223, 208, 172, 255, 223, 210, 173, 255, 221, 210, 171, 255, 221, 209, 168, 255, 221, 207, 165, 255, 222, 204, 161, 255, 222, 200, 158, 255, 223, 201, 158, 255, 223, 203, 159, 255, 223, 204, 158, 255, 221, 204, 157, 255, 221, 206, 162, 255, 225, 211, 169, 255, 225, 213, 174, 255, 219, 211, 174, 255, 216, 213, 174, 255, 221, 218, 176, 255, 82, 90, 102, 255, 31, 49, 94, 255, 50, 71, 116, 255, 48, 66, 110, 255, 24, 39, 81, 255, 35, 52, 88, 255, 54, 70, 109, 255, 15, 24, 61, 255, 118, 132, 143, 255, 172, 191, 229, 255, 77, 87, 178, 255, 91, 111, 191, 255, 151, 175, 201, 255, 29, 47, 74, 255, 45, 63, 104, 255, 59, 79, 123, 255, 94, 116, 158, 255, 113, 133, 172, 255, 42, 53, 88, 255, 94, 100, 104, 255, 191, 194, 174, 255, 49, 58, 83, 255, 160, 178, 198, 255, 129, 150, 183, 255, 116, 121, 117, 255, 229, 227, 185, 255, 207, 206, 171, 255, 207, 203, 167, 255, 212, 207, 176, 255, 206, 188, 155, 255, 203, 186, 153, 255, 203, 183, 149, 255, 206, 186, 150, 255, 208, 187, 152, 255, 205, 185, 148, 255, 206, 186, 148, 255, 206, 187, 147, 255, 204, 185, 144, 255, 204, 186, 144, 255, 204, 188, 145, 255, 204, 188, 146, 255, 205, 191, 149, 255, 206, 195, 152, 255, 204, 194, 152, 255, 209, 202, 167, 255, 222, 217, 193, 255, 222, 217, 195, 255, 221, 217, 194, 255, 220, 219, 196, 255, 221, 219, 198, 255, 223, 220, 199, 255, 224, 218, 199, 255, 224, 217, 198, 255, 224, 218, 197, 255, 227, 218, 199, 255, 226, 216, 193, 255, 223, 210, 180, 255, 221, 207, 177, 255, 222, 206, 175, 255, 222, 207, 175, 255, 224, 208, 176, 255, 226, 209, 175, 255, 226, 208, 173, 255, 223, 208, 173, 255, 223, 209, 175, 255, 222, 207, 174, 255, 219, 206, 168, 255, 219, 205, 164, 255, 219, 204, 161, 255, 219, 202, 159, 255, 220, 201, 158, 255, 224, 202, 159, 255, 227, 205, 160, 255, 225, 204, 160, 255, 221, 204, 158, 255, 223, 207, 164, 255, 224, 210, 172, 255, 224, 212, 175, 255, 220, 211, 174, 255, 220, 214, 173, 255, 224, 217, 176, 255, 147, 146, 127, 255, 90, 94, 91, 255, 78, 81, 85, 255, 117, 116, 109, 255, 41, 49, 74, 255, 54, 73, 121, 255, 62, 77, 121, 255, 30, 40, 71, 255, 198, 211, 221, 255, 110, 123, 193, 255, 90, 104, 191, 255, 155, 174, 198, 255, 26, 37, 60, 255, 16, 29, 58, 255, 21, 35, 68, 255, 45, 60, 89, 255, 51, 61, 78, 255, 148, 152, 147, 255, 237, 236, 207, 255, 227, 222, 179, 255, 197, 193, 165, 255, 86, 94, 105, 255, 40, 54, 85, 255, 163, 162, 150, 255, 226, 222, 187, 255, 209, 208, 176, 255, 212, 210, 179, 255, 213, 210, 181, 255, 210, 192, 162, 255, 205, 186, 155, 255, 205, 184, 153, 255, 206, 185, 151, 255, 207, 185, 150, 255, 206, 185, 149, 255, 205, 184, 148, 255, 205, 185, 148, 255, 205, 187, 149, 255, 205, 186, 147, 255, 205, 187, 147, 255, 205, 188, 147, 255, 205, 190, 149, 255, 206, 193, 151, 255, 208, 195, 156, 255, 218, 209, 181, 255, 223, 218, 195, 255, 221, 217, 194, 255, 222, 218, 196, 255, 224, 219, 199, 255, 222, 219, 198, 255, 222, 219, 199, 255, 223, 218, 198, 255, 224, 218, 199, 255, 225, 218, 198, 255, 226, 218, 198, 255, 223, 213, 188, 255, 222, 210, 181, 255, 222, 207, 178, 255, 221, 205, 175, 255, 221, 206, 174, 255, 224, 209, 177, 255, 226, 208, 176, 255, 226, 207, 173, 255, 226, 207, 174, 255, 223, 206, 173, 255, 221, 206, 173, 255, 223, 209, 176, 255, 221, 206, 168, 255, 220, 205, 162, 255, 218, 204, 160, 255, 218, 202, 158, 255, 221, 202, 158, 255, 223, 205, 160, 255, 223, 206, 160, 255, 223, 206, 161, 255, 222, 206, 164, 255, 225, 210, 172, 255, 228, 210, 174, 255, 226, 207, 172, 255, 222, 207, 171, 255, 219, 207, 169, 255, 231, 223, 177, 255, 233, 227, 179, 255, 231, 223, 177, 255, 246, 235, 185, 255, 176, 169, 146, 255, 49, 55, 75, 255, 39, 48, 78, 255, 32, 37, 66, 255, 91, 97, 115, 255, 192, 205, 231, 255, 148, 160, 217, 255, 129, 144, 164, 255, 120, 123, 123, 255, 206, 206, 186, 255, 170, 172, 160, 255, 188, 192, 174, 255, 230, 231, 203, 255, 232, 231, 195, 255, 204, 201, 160, 255, 205, 199, 161, 255, 222, 216, 180, 255, 226, 222, 187, 255, 178, 175, 157, 255, 215, 208, 180, 255, 219, 212, 183, 255, 216, 211, 183, 255, 216, 211, 184, 255, 214, 211, 184, 255, 217, 198, 171, 255, 211, 192, 164, 255, 208, 188, 159, 255, 205, 186, 154, 255, 204, 184, 150, 255, 205, 185, 150, 255, 204, 185, 149, 255, 204, 185, 148, 255, 203, 185, 148, 255, 204, 186, 149, 255, 204, 186, 147, 255, 204, 187, 147, 255, 204, 188, 147, 255, 207, 194, 154, 255, 217, 207, 178, 255, 226, 216, 195, 255, 224, 217, 194, 255, 223, 217, 195, 255, 224, 217, 198, 255, 224, 217, 198, 255, 224, 217, 197, 255, 223, 217, 197, 255, 221, 216, 195, 255, 224, 219, 200, 255, 225, 218, 199, 255, 225, 217, 196, 255, 224, 213, 190, 255, 222, 211, 186, 255, 222, 208, 181, 255, 223, 206, 177, 255, 221, 204, 175, 255, 223, 206, 176, 255, 224, 207, 175, 255, 225, 207, 174, 255, 225, 207, 175, 255, 224, 206, 174, 255, 224, 207, 176, 255, 223, 206, 175, 255, 222, 206, 170, 255, 220, 203, 163, 255, 219, 205, 162, 255, 217, 203, 159, 255, 219, 204, 160, 255, 220, 204, 160, 255, 222, 205, 159, 255, 223, 204, 161, 255, 224, 206, 166, 255, 224, 209, 171, 255, 226, 209, 174, 255, 227, 206, 173, 255, 223, 204, 170, 255, 219, 207, 169, 255, 210, 201, 160, 255, 207, 198, 154, 255, 211, 200, 156, 255, 210, 198, 156, 255, 229, 214, 168, 255, 225, 213, 170, 255, 198, 190, 159, 255, 238, 225, 193, 255, 115, 113, 114, 255, 117, 126, 148, 255, 240, 255, 255, 255, 63, 68, 96, 255, 189, 182, 163, 255, 244, 238, 205, 255, 237, 234, 204, 255, 229, 227, 191, 255, 200, 200, 154, 255, 194, 193, 148, 255, 204, 198, 157, 255, 207, 202, 162, 255, 209, 207, 173, 255, 213, 211, 181, 255, 225, 220, 188, 255, 218, 212, 186, 255, 218, 211, 188, 255, 220, 213, 189, 255, 218, 213, 188, 255, 215, 212, 187, 255, 219, 200, 175, 255, 217, 197, 171, 255, 213, 194, 167, 255, 210, 191, 163, 255, 207, 188, 156, 255, 205, 185, 152, 255, 205, 186, 151, 255, 205, 185, 150, 255, 205, 186, 149, 255, 204, 187, 149, 255, 205, 187, 150, 255, 205, 187, 148, 255, 207, 190, 152, 255, 208, 195, 158, 255, 223, 212, 190, 255, 226, 216, 195, 255, 226, 217, 196, 255, 226, 218, 198, 255, 225, 218, 198, 255, 224, 217, 198, 255, 224, 217, 197, 255, 222, 215, 195, 255, 222, 215, 195, 255, 225, 218, 198, 255, 224, 217, 197, 255, 226, 217, 197, 255, 224, 215, 193, 255, 222, 213, 189, 255, 220, 209, 184, 255, 223, 207, 180, 255, 222, 205, 178, 255, 222, 206, 179, 255, 224, 208, 180, 255, 224, 207, 178, 255, 224, 207, 176, 255, 224, 207, 176, 255, 225, 208, 178, 255, 225, 206, 175, 255, 223, 205, 171, 255, 221, 204, 165, 255, 220, 204, 162, 255, 218, 204, 160, 255, 219, 206, 162, 255, 219, 205, 161, 255, 219, 204, 160, 255, 222, 206, 163, 255, 224, 207, 167, 255, 225, 207, 171, 255, 226, 207, 174, 255, 225, 206, 173, 255, 224, 205, 172, 255, 221, 205, 171, 255, 219, 205, 167, 255, 218, 204, 161, 255, 218, 203, 159, 255, 217, 202, 158, 255, 214, 200, 157, 255, 214, 203, 160, 255, 224, 214, 175, 255, 223, 212, 177, 255, 233, 222, 187, 255, 96, 97, 113, 255, 50, 59, 99, 255, 107, 108, 112, 255, 237, 227, 188, 255, 205, 198, 157, 255, 206, 200, 159, 255, 201, 196, 152, 255, 202, 197, 152, 255, 202, 197, 154, 255, 204, 196, 155, 255, 207, 200, 160, 255, 210, 208, 175, 255, 215, 214, 189, 255, 215, 215, 191, 255, 217, 217, 195, 255, 221, 218, 197, 255, 220, 216, 194, 255, 218, 216, 195, 255, 219, 219, 198, 255, 219, 199, 176, 255, 218, 198, 173, 255, 216, 197, 172, 255, 213, 194, 167, 255, 208, 191, 161, 255, 206, 187, 156, 255, 205, 185, 152, 255, 206, 185, 151, 255, 205, 185, 150, 255, 205, 187, 150, 255, 205, 188, 150, 255, 205, 188, 150, 255, 208, 191, 154, 255, 211, 195, 163, 255, 220, 207, 183, 255, 224, 214, 193, 255, 225, 216, 195, 255, 225, 216, 196, 255, 224, 217, 197, 255, 224, 217, 197, 255, 222, 215, 195, 255, 221, 214, 194, 255, 223, 214, 195, 255, 225, 217, 197, 255, 224, 216, 196, 255, 225, 216, 196, 255, 225, 215, 194, 255, 223, 213, 190, 255, 222, 211, 188, 255, 221, 208, 182, 255, 222, 206, 179, 255, 221, 206, 180, 255, 221, 207, 181, 255, 221, 207, 180, 255, 222, 207, 179, 255, 223, 207, 178, 255, 225, 207, 178, 255, 225, 206, 175, 255, 223, 206, 171, 255, 222, 204, 166, 255, 220, 202, 162, 255, 219, 202, 161, 255, 219, 206, 162, 255, 219, 204, 161, 255, 219, 204, 160, 255, 221, 205, 163, 255, 222, 207, 167, 255, 223, 208, 171, 255, 225, 207, 173, 255, 222, 207, 173, 255, 223, 205, 172, 255, 221, 204, 170, 255, 222, 202, 168, 255, 224, 202, 168, 255, 221, 202, 163, 255, 219, 202, 160, 255, 218, 202, 160, 255, 216, 205, 164, 255, 216, 205, 168, 255, 215, 207, 172, 255, 224, 215, 178, 255, 212, 205, 178, 255, 114, 113, 119, 255, 218, 210, 176, 255, 215, 207, 163, 255, 204, 196, 151, 255, 203, 194, 150, 255, 204, 195, 152, 255, 204, 194, 151, 255, 205, 194, 153, 255, 206, 197, 157, 255, 206, 199, 160, 255, 209, 206, 175, 255, 220, 218, 197, 255, 218, 218, 196, 255, 219, 220, 199, 255, 220, 220, 200, 255, 217, 218, 195, 255, 219, 219, 200, 255, 219, 220, 200, 255, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	LOLTalentScout "main.go"
+	"main.go/config"
+	"main.go/lcu/models"
+	"main.go/store"
+	"slices"
+	"time"
+)
+
+// runCache 缓存管理，用法: cache stats | cache prune [-days 90] [-keep 0] [-dry-run]
+func runCache(g globalOptions, args []string) error {
+	if len(args) == 0 {
+		return newUsageError("用法: cache stats|prune")
+	}
+	if err := LOLTalentScout.Setup(g.options()); err != nil {
+		return err
+	}
+	switch args[0] {
+	case "stats":
+		fs := flag.NewFlagSet("cache stats", flag.ContinueOnError)
+		if err := parseFlags(fs, args[1:]); err != nil {
+			return err
+		}
+		return cacheStats()
+	case "prune":
+		fs := flag.NewFlagSet("cache prune", flag.ContinueOnError)
+		days := fs.Int("days", 90, "删除早于多少天的对局，0表示不按时间删除")
+		keep := fs.Int("keep", 0, "最多保留最近的几局，0表示不限制")
+		dryRun := fs.Bool("dry-run", false, "只列出要删除的对局，不真正删除")
+		if err := parseFlags(fs, args[1:]); err != nil {
+			return err
+		}
+		if *days < 0 || *keep < 0 {
+			return newUsageError("-days和-keep不能为负数")
+		}
+		return cachePrune(*days, *keep, *dryRun)
+	}
+	return newUsageError("未知的缓存命令:%s,可选:stats/prune", args[0])
+}
+
+// cacheStats 各分类的记录条数和占用空间，以及缓存对局的时间范围
+func cacheStats() error {
+	stats, err := store.Stats()
+	if err != nil {
+		return err
+	}
+	fmt.Println("数据目录:", config.Get().DataDir)
+	if len(stats) == 0 {
+		fmt.Println("暂无数据")
+		return nil
+	}
+	for _, stat := range stats {
+		fmt.Printf("  %-12s%6d条\t%s\n", stat.Kind, stat.Count, formatBytes(stat.Bytes))
+	}
+	games, err := store.LoadGames()
+	if err != nil {
+		return err
+	}
+	if len(games) > 0 {
+		first, last := gameTimeRange(games)
+		fmt.Printf("缓存对局%d局: %s ~ %s\n", len(games), first.Local().Format("2006-01-02"),
+			last.Local().Format("2006-01-02"))
+	}
+	return nil
+}
+
+// cachePrune 按时间和数量清理缓存的对局详情，备注和赛后报告不会删除
+func cachePrune(days, keep int, dryRun bool) error {
+	games, err := store.LoadGames()
+	if err != nil {
+		return err
+	}
+	slices.SortFunc(games, func(a, b models.GameSummary) int {
+		return b.GameCreationDate.Compare(a.GameCreationDate)
+	})
+	deadline := time.Now().AddDate(0, 0, -days)
+	removed := 0
+	for i, game := range games {
+		expired := days > 0 && game.GameCreationDate.Before(deadline)
+		overflow := keep > 0 && i >= keep
+		if !expired && !overflow {
+			continue
+		}
+		removed++
+		if dryRun {
+			fmt.Println("将删除对局", game.GameId, game.GameCreationDate.Local().Format("2006-01-02 15:04"))
+			continue
+		}
+		if err = store.DeleteGame(game.GameId); err != nil {
+			return err
+		}
+	}
+	if dryRun {
+		fmt.Printf("共%d局，将删除%d局\n", len(games), removed)
+		return nil
+	}
+	fmt.Printf("共%d局，已删除%d局\n", len(games), removed)
+	return nil
+}
+
+// gameTimeRange 对局的最早和最晚时间
+func gameTimeRange(games []models.GameSummary) (time.Time, time.Time) {
+	first, last := games[0].GameCreationDate, games[0].GameCreationDate
+	for _, game := range games[1:] {
+		if game.GameCreationDate.Before(first) {
+			first = game.GameCreationDate
+		}
+		if game.GameCreationDate.After(last) {
+			last = game.GameCreationDate
+		}
+	}
+	return first, last
+}
+
+// formatBytes 占用空间，例如 1.5MB
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%dB", n)
+	}
+	value, suffix := float64(n)/unit, "KB"
+	for _, next := range []string{"MB", "GB"} {
+		if value < unit {
+			break
+		}
+		value, suffix = value/unit, next
+	}
+	return fmt.Sprintf("%.1f%s", value, suffix)
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"main.go/config"
+	"main.go/loadout"
+	"main.go/runes"
+	"main.go/scores"
+	"main.go/script"
+	"slices"
+)
+
+// runConfig 配置相关命令，目前只有 config validate
+func runConfig(g globalOptions, args []string) error {
+	if len(args) == 0 || args[0] != "validate" {
+		return newUsageError("用法: config validate")
+	}
+	fs := flag.NewFlagSet("config validate", flag.ContinueOnError)
+	if err := parseFlags(fs, args[1:]); err != nil {
+		return err
+	}
+	return validateConfig(g.configPath)
+}
+
+// validateConfig 检查配置文件的字段和取值，以及其中引用的评分模型、规则文件和脚本
+func validateConfig(path string) error {
+	c, err := config.LoadStrict(path)
+	if err != nil {
+		return fmt.Errorf("读取%s失败: %w", path, err)
+	}
+	var errs []error
+	if err = c.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	if !slices.Contains([]string{scores.WeightingWindow, scores.WeightingDecay}, c.Weighting.Strategy) {
+		errs = append(errs, fmt.Errorf("weighting.strategy未知:%s", c.Weighting.Strategy))
+	}
+	if !slices.Contains([]string{scores.PriorGlobal, scores.PriorRank}, c.Shrinkage.Prior) {
+		errs = append(errs, fmt.Errorf("shrinkage.prior未知:%s", c.Shrinkage.Prior))
+	}
+	scorerNames := scores.ScorerNames()
+	checkScorer := func(field, name string) {
+		if !slices.Contains(scorerNames, name) {
+			errs = append(errs, fmt.Errorf("%s的评分模型%s不存在,可选:%v", field, name, scorerNames))
+		}
+	}
+	checkScorer("scoring.default", c.Scoring.Default)
+	for queueID, name := range c.Scoring.Queues {
+		checkScorer(fmt.Sprintf("scoring.queues.%d", queueID), name)
+	}
+	if c.Scoring.Compare != "" {
+		checkScorer("scoring.compare", c.Scoring.Compare)
+	}
+	if c.Scoring.RulesFile != "" {
+		if _, err = scores.LoadRuleSet(c.Scoring.RulesFile); err != nil {
+			errs = append(errs, fmt.Errorf("scoring.rulesFile: %w", err))
+		}
+	}
+	// 脚本目录相对于被检查的配置文件
+	if err = config.Init(path); err != nil {
+		return err
+	}
+	if err = script.Validate(c.Script); err != nil {
+		errs = append(errs, fmt.Errorf("脚本: %w", err))
+	}
+	if err = runes.Validate(c.Runes); err != nil {
+		errs = append(errs, fmt.Errorf("符文预设: %w", err))
+	}
+	if err = loadout.Validate(c.Loadout); err != nil {
+		errs = append(errs, fmt.Errorf("召唤师技能和装备方案预设: %w", err))
+	}
+	if err = errors.Join(errs...); err != nil {
+		return err
+	}
+	fmt.Println(path, "检查通过")
+	return nil
+}
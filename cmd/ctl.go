@@ -0,0 +1,193 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	LOLTalentScout "main.go"
+	"main.go/config"
+	"main.go/liveclient"
+	"main.go/timers"
+	"net/http"
+	"os"
+	"time"
+)
+
+const ctlUsage = "用法: ctl [-addr 127.0.0.1:8866] [-json] status|alerts|live|timers|mark|confirm|auto-accept on|off"
+
+// runCtl 通过HTTP接口控制运行中的伯乐
+func runCtl(g globalOptions, args []string) error {
+	fs := flag.NewFlagSet("ctl", flag.ContinueOnError)
+	addr := fs.String("addr", "", "HTTP接口地址，默认使用配置文件的api.addr")
+	asJSON := fs.Bool("json", false, "以json格式输出")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() == 0 {
+		return newUsageError(ctlUsage)
+	}
+	if *addr == "" {
+		if err := config.Init(g.configPath); err != nil {
+			return err
+		}
+		*addr = config.Get().API.Addr
+	}
+	if *addr == "" {
+		return newUsageError("配置文件未开启HTTP接口,请用-addr指定地址")
+	}
+	token, err := LOLTalentScout.LoadAPIToken(g.configPath)
+	if err != nil {
+		return fmt.Errorf("读取接口令牌失败,请确认伯乐已开启过HTTP接口: %w", err)
+	}
+	cli := ctlClient{baseURL: "http://" + *addr, token: token, http: &http.Client{Timeout: 5 * time.Second}}
+	switch action := fs.Arg(0); action {
+	case "status":
+		status := LOLTalentScout.Status{}
+		if err := cli.do(http.MethodGet, "/api/status", nil, &status); err != nil {
+			return err
+		}
+		if *asJSON {
+			return writeJSON(os.Stdout, status)
+		}
+		fmt.Println("客户端状态:", status.GameState)
+		fmt.Println("已连接客户端:", status.LCUActive, status.Summoner)
+		fmt.Println("自动接受对局:", status.AutoAccept)
+		if status.Pending != "" {
+			fmt.Println("等待确认:", status.Pending)
+		}
+		return nil
+	case "alerts":
+		var alerts []LOLTalentScout.Alert
+		if err := cli.do(http.MethodGet, "/api/alerts", nil, &alerts); err != nil {
+			return err
+		}
+		if *asJSON {
+			return writeJSON(os.Stdout, alerts)
+		}
+		for _, alert := range alerts {
+			fmt.Println(alert.Time.Local().Format("2006-01-02 15:04:05"), alert.Message)
+		}
+		return nil
+	case "live":
+		snapshot := liveclient.Snapshot{}
+		if err := cli.do(http.MethodGet, "/api/live", nil, &snapshot); err != nil {
+			return err
+		}
+		if *asJSON {
+			return writeJSON(os.Stdout, snapshot)
+		}
+		printLiveSnapshot(snapshot)
+		return nil
+	case "timers":
+		status := LOLTalentScout.TimersStatus{}
+		if err := cli.do(http.MethodGet, "/api/timers", nil, &status); err != nil {
+			return err
+		}
+		if *asJSON {
+			return writeJSON(os.Stdout, status)
+		}
+		for i, enemy := range status.Enemies {
+			fmt.Printf("%d. %s(%s) 召唤师技能急速:%.0f\n", i+1, enemy.Champion, enemy.Name, enemy.Haste)
+		}
+		for _, timer := range status.Timers {
+			fmt.Printf("%s %s后好(%s)\n", timer.Label, liveclient.FormatGameTime(timer.Remaining),
+				liveclient.FormatGameTime(timer.ReadyAt))
+		}
+		fmt.Println(status.ChatLine)
+		return nil
+	case "mark":
+		if fs.NArg() < 2 || fs.NArg() > 3 {
+			return newUsageError("用法: ctl mark 敌方序号|英雄|玩家 [flash|tp|other|1|2]")
+		}
+		req := LOLTalentScout.MarkSpellReq{Target: fs.Arg(1), Spell: fs.Arg(2)}
+		timer := timers.Timer{}
+		if err := cli.do(http.MethodPost, "/api/timers/mark", req, &timer); err != nil {
+			return err
+		}
+		fmt.Printf("已标记%s,%s后好(%s)\n", timer.Label, liveclient.FormatGameTime(timer.Remaining),
+			liveclient.FormatGameTime(timer.ReadyAt))
+		return nil
+	case "confirm":
+		if err := cli.do(http.MethodPost, "/api/confirm", nil, nil); err != nil {
+			return err
+		}
+		fmt.Println("已确认")
+		return nil
+	case "auto-accept":
+		if fs.NArg() != 2 || (fs.Arg(1) != "on" && fs.Arg(1) != "off") {
+			return newUsageError("用法: ctl auto-accept on|off")
+		}
+		req := LOLTalentScout.AutoAcceptReq{Enabled: fs.Arg(1) == "on"}
+		if err := cli.do(http.MethodPut, "/api/auto-accept", req, &req); err != nil {
+			return err
+		}
+		fmt.Println("自动接受对局:", req.Enabled)
+		return nil
+	default:
+		return newUsageError("未知的操作:%s\n%s", action, ctlUsage)
+	}
+}
+
+// printLiveSnapshot 输出游戏内记分板，我方在前
+func printLiveSnapshot(s liveclient.Snapshot) {
+	fmt.Printf("游戏时间 %s  %s\n", liveclient.FormatGameTime(s.GameTime), s.GameMode)
+	for _, team := range []liveclient.Team{s.SelfTeam, s.SelfTeam.Opponent()} {
+		side := "敌方"
+		if team == s.SelfTeam {
+			side = "我方"
+		}
+		state := s.Teams[team]
+		fmt.Printf("%s 击杀:%d 装备:%d 小龙:%v 先锋:%d 男爵:%d 推塔:%d 水晶:%d\n", side, state.Kills, state.ItemGold,
+			state.Dragons, state.Heralds, state.Barons, state.Turrets, state.Inhibs)
+		for _, p := range s.Players {
+			if p.Team == team {
+				fmt.Printf("  %s(%s) Lv%d %d/%d/%d 补刀:%d 装备:%d\n", p.Name, p.Champion, p.Level, p.Kills, p.Deaths,
+					p.Assists, p.CreepScore, p.ItemGold)
+			}
+		}
+	}
+}
+
+// ctlClient 伯乐HTTP接口的客户端
+type ctlClient struct {
+	baseURL string
+	token   string // 伯乐启动HTTP接口时生成的令牌
+	http    *http.Client
+}
+
+// do 发送请求，resp不为空时解析返回的json
+func (c ctlClient) do(method, path string, body, resp any) error {
+	var reqBody bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&reqBody).Encode(body); err != nil {
+			return err
+		}
+	}
+	req, err := http.NewRequest(method, c.baseURL+path, &reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(LOLTalentScout.APITokenHeader, c.token)
+	httpResp, err := c.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("连接伯乐失败,请确认伯乐正在运行且开启了HTTP接口: %w", err)
+	}
+	defer httpResp.Body.Close()
+	if httpResp.StatusCode != http.StatusOK {
+		apiErr := struct {
+			Error string `json:"error"`
+		}{}
+		_ = json.NewDecoder(httpResp.Body).Decode(&apiErr)
+		if apiErr.Error == "" {
+			apiErr.Error = httpResp.Status
+		}
+		return errors.New(apiErr.Error)
+	}
+	if resp == nil {
+		return nil
+	}
+	return json.NewDecoder(httpResp.Body).Decode(resp)
+}
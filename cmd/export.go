@@ -0,0 +1,119 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	LOLTalentScout "main.go"
+	"main.go/notes"
+	"main.go/staticdata"
+	"main.go/store"
+	"os"
+	"strconv"
+)
+
+// runExport 导出本地数据，用法: export [-what reports|notes|games] [-format json|csv] [-out 文件]
+func runExport(g globalOptions, args []string) error {
+	fs := flag.NewFlagSet("export", flag.ContinueOnError)
+	what := fs.String("what", "reports", "导出内容 reports:赛后报告 notes:玩家备注和相遇记录 games:缓存对局(每行一局)")
+	format := fs.String("format", "json", "导出格式 json/csv，csv只支持赛后报告")
+	out := fs.String("out", "", "输出文件，默认输出到命令行")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if *format != "json" && *format != "csv" {
+		return newUsageError("未知的导出格式:%s", *format)
+	}
+	if *format == "csv" && *what != "reports" {
+		return newUsageError("csv只支持导出赛后报告")
+	}
+	if err := LOLTalentScout.Setup(g.options()); err != nil {
+		return err
+	}
+	var w io.Writer = os.Stdout
+	if *out != "" {
+		file, err := os.Create(*out)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		w = file
+	}
+	switch *what {
+	case "reports":
+		return exportReports(w, *format)
+	case "notes":
+		records, err := notes.ListRecords()
+		if err != nil {
+			return err
+		}
+		return writeJSON(w, records)
+	case "games":
+		return exportGames(w)
+	}
+	return newUsageError("未知的导出内容:%s,可选:reports/notes/games", *what)
+}
+
+// exportReports 导出赛后报告，csv每行一名玩家
+func exportReports(w io.Writer, format string) error {
+	reports, err := LOLTalentScout.ListPostGameReports()
+	if err != nil {
+		return err
+	}
+	if format == "json" {
+		return writeJSON(w, reports)
+	}
+	cw := csv.NewWriter(w)
+	_ = cw.Write([]string{"gameID", "time", "queueID", "duration", "puuid", "name", "championID", "champion", "teamID", "win",
+		"kills", "deaths", "assists", "score", "reasons"})
+	for _, report := range reports {
+		for _, player := range report.Players {
+			_ = cw.Write([]string{
+				strconv.FormatInt(report.GameID, 10),
+				report.GameCreationDate.Local().Format("2006-01-02 15:04:05"),
+				strconv.Itoa(report.QueueID),
+				strconv.Itoa(report.GameDuration),
+				player.Puuid,
+				player.SummonerName,
+				strconv.Itoa(player.ChampionID),
+				staticdata.ChampionName(player.ChampionID),
+				fmt.Sprint(player.TeamID),
+				strconv.FormatBool(player.Win),
+				strconv.Itoa(player.KDA[0]),
+				strconv.Itoa(player.KDA[1]),
+				strconv.Itoa(player.KDA[2]),
+				strconv.FormatFloat(player.Score, 'f', 2, 64),
+				player.Reasons,
+			})
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// exportGames 导出缓存对局，每行一局json，方便流式处理
+func exportGames(w io.Writer) error {
+	ids, err := store.ListGameIDs()
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(w)
+	for _, id := range ids {
+		gameSummary, ok := store.LoadGame(id)
+		if !ok {
+			continue
+		}
+		if err = encoder.Encode(gameSummary); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeJSON(w io.Writer, v any) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	LOLTalentScout "main.go"
+	"main.go/lcu/models"
+	"main.go/staticdata"
+	"os"
+	"strings"
+)
+
+// connect 加载配置并连接客户端，查询玩家时使用
+func connect(g globalOptions) error {
+	if err := LOLTalentScout.Setup(g.options()); err != nil {
+		return err
+	}
+	return LOLTalentScout.ConnectLCU(g.lcuPort, g.lcuToken)
+}
+
+// parseRiotID 检查 名字#编号 格式
+func parseRiotID(fs *flag.FlagSet) (string, error) {
+	if fs.NArg() != 1 {
+		return "", newUsageError("需要一个玩家名，格式为 名字#编号")
+	}
+	riotID := fs.Arg(0)
+	name, tag, ok := strings.Cut(riotID, "#")
+	if !ok || name == "" || tag == "" {
+		return "", newUsageError("玩家名格式应为 名字#编号:%s", riotID)
+	}
+	return riotID, nil
+}
+
+// runLookup 查询一名玩家的评分，用法: lookup [-json] [-queue 420] 名字#编号
+func runLookup(g globalOptions, args []string) error {
+	fs := flag.NewFlagSet("lookup", flag.ContinueOnError)
+	asJSON := fs.Bool("json", false, "以json格式输出")
+	queueID := fs.Int("queue", 0, "按队列选择评分模型，例如420单排，默认使用默认模型")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	riotID, err := parseRiotID(fs)
+	if err != nil {
+		return err
+	}
+	if err = connect(g); err != nil {
+		return err
+	}
+	summoner, err := LOLTalentScout.FindSummoner(riotID)
+	if err != nil {
+		return err
+	}
+	userScore, err := LOLTalentScout.GetUserScore(summoner, *queueID)
+	if err != nil {
+		return err
+	}
+	if *asJSON {
+		return writeJSON(os.Stdout, userScore)
+	}
+	kda := make([]string, 0, len(userScore.CurrKDA))
+	for _, item := range userScore.CurrKDA {
+		kda = append(kda, fmt.Sprintf("%d/%d/%d", item[0], item[1], item[2]))
+	}
+	fmt.Printf("%s\t[%s]%s%s-评分: %d(%s)%s 单双:%s 灵活:%s 最近对局:%s\n", riotID, userScore.HorseLabel(),
+		userScore.SmurfDetail(), userScore.TagDetail(), int(userScore.Score), userScore.ConfidenceDetail(),
+		userScore.CompareDetail(), userScore.SoloRank.Detail(), userScore.FlexRank.Detail(), strings.Join(kda, "  "))
+	return nil
+}
+
+// runHistory 列出一名玩家参与评分的对局，用法: history [-json] [-queue 420] 名字#编号
+func runHistory(g globalOptions, args []string) error {
+	fs := flag.NewFlagSet("history", flag.ContinueOnError)
+	asJSON := fs.Bool("json", false, "以json格式输出")
+	queueID := fs.Int("queue", 0, "按队列选择评分模型，例如420单排，默认使用默认模型")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	riotID, err := parseRiotID(fs)
+	if err != nil {
+		return err
+	}
+	if err = connect(g); err != nil {
+		return err
+	}
+	summoner, err := LOLTalentScout.FindSummoner(riotID)
+	if err != nil {
+		return err
+	}
+	items, err := LOLTalentScout.ListScoredHistory(summoner, *queueID)
+	if err != nil {
+		return err
+	}
+	if *asJSON {
+		return writeJSON(os.Stdout, items)
+	}
+	fmt.Printf("%s 参与评分的对局%d局:\n", riotID, len(items))
+	for _, item := range items {
+		result := "负"
+		if item.Win {
+			result = "胜"
+		}
+		fmt.Printf("%s\t%d\t%s\t%s\t%d/%d/%d\t%s\t%.1f\t%s\n", item.Time.Local().Format("2006-01-02 15:04"),
+			item.GameID, queueName(item.QueueID), staticdata.ChampionName(item.ChampionID), item.KDA[0], item.KDA[1], item.KDA[2], result,
+			item.Score, item.Reasons)
+	}
+	return nil
+}
+
+// queueName 常见队列的名称
+func queueName(queueID int) string {
+	switch models.GameQueueID(queueID) {
+	case models.NormalQueueID:
+		return "匹配"
+	case models.RankSoleQueueID:
+		return "单双排"
+	case models.RankFlexQueueID:
+		return "灵活组排"
+	case models.ARAMQueueID:
+		return "大乱斗"
+	}
+	return fmt.Sprintf("队列%d", queueID)
+}
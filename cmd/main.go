@@ -1,8 +1,200 @@
 package main
 
-import LOLTalentScout "main.go"
+import (
+	"errors"
+	"flag"
+	"fmt"
+	LOLTalentScout "main.go"
+	"main.go/backtest"
+	"main.go/config"
+	"main.go/fit"
+	"os"
+	"slices"
+)
+
+// 退出码，方便脚本判断结果
+const (
+	exitOK    = 0 // 成功
+	exitError = 1 // 执行失败
+	exitUsage = 2 // 命令或参数错误
+	exitLCU   = 3 // 无法连接LOL客户端
+)
+
+const usage = `用法: LOLTalentScout [全局参数] <命令> [命令参数]
+
+命令:
+  run                     启动伯乐，监控客户端并自动评分(默认)，-headless 无界面运行
+  ctl                     控制运行中的伯乐: status/alerts/confirm/auto-accept on|off
+  lookup <名字#编号>      查询一名玩家的评分
+  history <名字#编号>     列出一名玩家参与评分的对局和每局得分
+  config validate         检查配置文件、评分规则和脚本
+  cache stats             统计本地数据目录
+  cache prune             清理缓存的对局详情
+  notes                   管理玩家备注: add/tag/rate/list/rm <名字#编号>
+  export                  导出赛后报告、玩家备注或缓存对局
+  update <数据包.json>    导入新版本的英雄、装备、符文和召唤师技能数据
+  backtest                用缓存对局回测评分
+  fit                     用缓存对局拟合得分标准
+
+全局参数:
+`
+
+// globalOptions 全局参数
+type globalOptions struct {
+	configPath string
+	logLevel   string
+	lcuPort    int
+	lcuToken   string
+}
+
+// options 转成伯乐的启动参数
+func (g globalOptions) options() LOLTalentScout.Options {
+	return LOLTalentScout.Options{
+		ConfigPath: g.configPath,
+		LogLevel:   g.logLevel,
+		LCUPort:    g.lcuPort,
+		LCUToken:   g.lcuToken,
+	}
+}
+
+// usageError 命令或参数错误
+type usageError struct {
+	msg string
+}
+
+func (e usageError) Error() string {
+	return e.msg
+}
+
+func newUsageError(format string, args ...any) error {
+	return usageError{msg: fmt.Sprintf(format, args...)}
+}
+
+// command 子命令，desc用于失败时的提示
+type command struct {
+	name string
+	desc string
+	run  func(g globalOptions, args []string) error
+}
+
+var commands = []command{
+	{name: "run", desc: "运行", run: runTalentScout},
+	{name: "ctl", desc: "控制", run: runCtl},
+	{name: "lookup", desc: "查询", run: runLookup},
+	{name: "history", desc: "查询", run: runHistory},
+	{name: "config", desc: "检查配置", run: runConfig},
+	{name: "cache", desc: "缓存管理", run: runCache},
+	{name: "notes", desc: "备注", run: runNotes},
+	{name: "export", desc: "导出", run: runExport},
+	{name: "update", desc: "更新静态数据", run: runUpdate},
+	{name: "backtest", desc: "回测", run: func(g globalOptions, args []string) error {
+		return backtest.Command(withConfigFlag(g, args))
+	}},
+	{name: "fit", desc: "拟合", run: func(g globalOptions, args []string) error {
+		return fit.Command(withConfigFlag(g, args))
+	}},
+}
 
 func main() {
-	talentScout := LOLTalentScout.NewTalentScout()
+	os.Exit(run(os.Args[1:]))
+}
+
+// run 解析全局参数并执行子命令，返回退出码
+func run(args []string) int {
+	g := globalOptions{}
+	fs := flag.NewFlagSet("LOLTalentScout", flag.ContinueOnError)
+	fs.StringVar(&g.configPath, "config", config.DefaultPath, "配置文件路径")
+	fs.StringVar(&g.logLevel, "log-level", "", "日志级别 debug/info/warn/error，默认使用配置文件")
+	fs.IntVar(&g.lcuPort, "lcu-port", 0, "指定LOL客户端端口，需要和-lcu-token一起使用")
+	fs.StringVar(&g.lcuToken, "lcu-token", "", "指定LOL客户端token")
+	fs.Usage = func() {
+		fmt.Fprint(fs.Output(), usage)
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK
+		}
+		return exitUsage
+	}
+	if g.logLevel != "" && !slices.Contains(config.LogLevels, g.logLevel) {
+		fmt.Println("日志级别应为", config.LogLevels, "之一")
+		return exitUsage
+	}
+	if (g.lcuPort == 0) != (g.lcuToken == "") {
+		fmt.Println("-lcu-port和-lcu-token需要同时指定")
+		return exitUsage
+	}
+	// 不带命令时保持双击运行的行为
+	name := "run"
+	if fs.NArg() > 0 {
+		name = fs.Arg(0)
+	}
+	idx := slices.IndexFunc(commands, func(c command) bool { return c.name == name })
+	if idx < 0 {
+		fmt.Println("未知的命令:", name)
+		fs.Usage()
+		return exitUsage
+	}
+	cmd := commands[idx]
+	var subArgs []string
+	if fs.NArg() > 1 {
+		subArgs = fs.Args()[1:]
+	}
+	err := cmd.run(g, subArgs)
+	code := exitCode(err)
+	if code != exitOK {
+		fmt.Println(cmd.desc+"失败:", err)
+	}
+	return code
+}
+
+// exitCode 根据错误类型给出退出码
+func exitCode(err error) int {
+	var usageErr usageError
+	switch {
+	case err == nil, errors.Is(err, flag.ErrHelp):
+		return exitOK
+	case errors.As(err, &usageErr):
+		return exitUsage
+	case errors.Is(err, LOLTalentScout.ErrLCUNotFound):
+		return exitLCU
+	default:
+		return exitError
+	}
+}
+
+// parseFlags 解析子命令参数，参数错误时返回usageError
+func parseFlags(fs *flag.FlagSet, args []string) error {
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return err
+		}
+		return usageError{msg: err.Error()}
+	}
+	return nil
+}
+
+// withConfigFlag 把全局配置文件路径传给自带-config参数的子命令，子命令参数优先
+func withConfigFlag(g globalOptions, args []string) []string {
+	return append([]string{"-config", g.configPath}, args...)
+}
+
+// runTalentScout 启动伯乐，用法: run [-headless] [-auto-accept=false]
+func runTalentScout(g globalOptions, args []string) error {
+	fs := flag.NewFlagSet("run", flag.ContinueOnError)
+	headless := fs.Bool("headless", false, "无界面模式，不启动通知栏，开关通过ctl命令或HTTP接口控制，日志写入文件")
+	autoAccept := fs.Bool("auto-accept", true, "是否自动接受对局")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return newUsageError("run不需要参数:%v", fs.Args())
+	}
+	opts := g.options()
+	opts.Headless = *headless
+	opts.NoAutoAccept = !*autoAccept
+	talentScout := LOLTalentScout.NewTalentScoutWithOptions(opts)
 	talentScout.Run()
+	return nil
 }
@@ -0,0 +1,214 @@
+package main
+
+import (
+	"cmp"
+	"flag"
+	"fmt"
+	LOLTalentScout "main.go"
+	"main.go/notes"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// runNotes 管理玩家备注，用法: notes add|tag|rate|list|rm
+func runNotes(g globalOptions, args []string) error {
+	if len(args) == 0 {
+		return newUsageError("用法: notes add|tag|rate|list|rm")
+	}
+	if err := LOLTalentScout.Setup(g.options()); err != nil {
+		return err
+	}
+	switch args[0] {
+	case "add":
+		fs := flag.NewFlagSet("notes add", flag.ContinueOnError)
+		list := fs.String("list", "", "加入名单 black:黑名单 watch:关注名单 none:移出名单，默认不修改")
+		if err := parseFlags(fs, args[1:]); err != nil {
+			return err
+		}
+		if fs.NArg() < 1 {
+			return newUsageError("用法: notes add [-list black|watch|none] 名字#编号 [备注内容]")
+		}
+		if !slices.Contains([]string{"", "none", string(notes.ListBlack), string(notes.ListWatch)}, *list) {
+			return newUsageError("-list应为black/watch/none之一:%s", *list)
+		}
+		note, err := findNote(g, fs.Arg(0), true)
+		if err != nil {
+			return err
+		}
+		if text := strings.Join(fs.Args()[1:], " "); text != "" {
+			note.Text = text
+		}
+		switch *list {
+		case "":
+		case "none":
+			note.List = notes.ListNone
+		default:
+			note.List = notes.List(*list)
+		}
+		return saveNote(note)
+	case "tag":
+		fs := flag.NewFlagSet("notes tag", flag.ContinueOnError)
+		remove := fs.Bool("d", false, "删除标签")
+		if err := parseFlags(fs, args[1:]); err != nil {
+			return err
+		}
+		if fs.NArg() < 2 {
+			return newUsageError("用法: notes tag [-d] 名字#编号 标签...")
+		}
+		note, err := findNote(g, fs.Arg(0), !*remove)
+		if err != nil {
+			return err
+		}
+		for _, tag := range fs.Args()[1:] {
+			idx := slices.Index(note.Tags, tag)
+			if *remove && idx >= 0 {
+				note.Tags = slices.Delete(note.Tags, idx, idx+1)
+			} else if !*remove && idx < 0 {
+				note.Tags = append(note.Tags, tag)
+			}
+		}
+		return saveNote(note)
+	case "rate":
+		fs := flag.NewFlagSet("notes rate", flag.ContinueOnError)
+		if err := parseFlags(fs, args[1:]); err != nil {
+			return err
+		}
+		if fs.NArg() != 2 {
+			return newUsageError("用法: notes rate 名字#编号 -5~5")
+		}
+		rating, err := strconv.Atoi(fs.Arg(1))
+		if err != nil || rating < -5 || rating > 5 {
+			return newUsageError("评价应为-5~5的整数:%s", fs.Arg(1))
+		}
+		note, err := findNote(g, fs.Arg(0), true)
+		if err != nil {
+			return err
+		}
+		note.Rating = rating
+		return saveNote(note)
+	case "list":
+		fs := flag.NewFlagSet("notes list", flag.ContinueOnError)
+		list := fs.String("list", "", "只列出某个名单 black/watch，默认全部")
+		asJSON := fs.Bool("json", false, "以json格式输出")
+		if err := parseFlags(fs, args[1:]); err != nil {
+			return err
+		}
+		if !slices.Contains([]string{"", string(notes.ListBlack), string(notes.ListWatch)}, *list) {
+			return newUsageError("-list应为black/watch之一:%s", *list)
+		}
+		return listNotes(notes.List(*list), *asJSON)
+	case "rm":
+		fs := flag.NewFlagSet("notes rm", flag.ContinueOnError)
+		if err := parseFlags(fs, args[1:]); err != nil {
+			return err
+		}
+		riotID, err := parseRiotID(fs)
+		if err != nil {
+			return err
+		}
+		note, err := findNote(g, riotID, false)
+		if err != nil {
+			return err
+		}
+		if err = notes.Delete(note.Puuid); err != nil {
+			return err
+		}
+		fmt.Println("已删除备注:", note.Name)
+		return nil
+	}
+	return newUsageError("未知的备注命令:%s,可选:add/tag/rate/list/rm", args[0])
+}
+
+// findNote 按 名字#编号 找到玩家备注，备注里记着这个名字时不需要连接客户端
+// 否则通过客户端查询puuid，create为true时没有备注的玩家新建备注
+func findNote(g globalOptions, riotID string, create bool) (*notes.Note, error) {
+	if name, tag, ok := strings.Cut(riotID, "#"); !ok || name == "" || tag == "" {
+		return nil, newUsageError("玩家名格式应为 名字#编号:%s", riotID)
+	}
+	list, err := notes.ListNotes()
+	if err != nil {
+		return nil, err
+	}
+	for _, note := range list {
+		if strings.EqualFold(note.Name, riotID) {
+			return note, nil
+		}
+	}
+	if err = LOLTalentScout.ConnectLCU(g.lcuPort, g.lcuToken); err != nil {
+		return nil, err
+	}
+	summoner, err := LOLTalentScout.FindSummoner(riotID)
+	if err != nil {
+		return nil, err
+	}
+	note, ok := notes.Get(summoner.Puuid)
+	if !ok {
+		if !create {
+			return nil, fmt.Errorf("没有%s的备注", riotID)
+		}
+		note = &notes.Note{Puuid: summoner.Puuid}
+	}
+	note.Name = summoner.GameName + "#" + summoner.TagLine
+	return note, nil
+}
+
+// saveNote 保存并输出备注
+func saveNote(note *notes.Note) error {
+	if err := notes.Save(note); err != nil {
+		return err
+	}
+	fmt.Println("已保存备注:", formatNote(note))
+	return nil
+}
+
+// listNotes 列出玩家备注，按更新时间从新到旧
+func listNotes(list notes.List, asJSON bool) error {
+	all, err := notes.ListNotes()
+	if err != nil {
+		return err
+	}
+	if list != notes.ListNone {
+		all = slices.DeleteFunc(all, func(note *notes.Note) bool { return note.List != list })
+	}
+	slices.SortFunc(all, func(a, b *notes.Note) int {
+		return cmp.Compare(b.UpdatedAt.Unix(), a.UpdatedAt.Unix())
+	})
+	if asJSON {
+		return writeJSON(os.Stdout, all)
+	}
+	if len(all) == 0 {
+		fmt.Println("暂无备注")
+		return nil
+	}
+	for _, note := range all {
+		fmt.Println(formatNote(note))
+	}
+	return nil
+}
+
+// formatNote 一行备注，例如 [黑名单]张三#1234 备注:挂机 标签:演员 评价:-3 上次相遇:2025-03-01 21:30
+func formatNote(note *notes.Note) string {
+	sb := strings.Builder{}
+	switch note.List {
+	case notes.ListBlack:
+		sb.WriteString("[黑名单]")
+	case notes.ListWatch:
+		sb.WriteString("[关注]")
+	}
+	sb.WriteString(note.Name)
+	if note.Text != "" {
+		sb.WriteString(" 备注:" + note.Text)
+	}
+	if len(note.Tags) > 0 {
+		sb.WriteString(" 标签:" + strings.Join(note.Tags, ","))
+	}
+	if note.Rating != 0 {
+		sb.WriteString(fmt.Sprintf(" 评价:%d", note.Rating))
+	}
+	if !note.LastMetAt.IsZero() {
+		sb.WriteString(" 上次相遇:" + note.LastMetAt.Local().Format("2006-01-02 15:04"))
+	}
+	return sb.String()
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	LOLTalentScout "main.go"
+	"main.go/staticdata"
+)
+
+// runUpdate 导入静态数据包，用法: update [-force] <数据包.json>，不带文件时显示当前版本
+func runUpdate(g globalOptions, args []string) error {
+	fs := flag.NewFlagSet("update", flag.ContinueOnError)
+	force := fs.Bool("force", false, "版本不比当前的新时也导入")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() > 1 {
+		return newUsageError("用法: update [-force] <数据包.json>")
+	}
+	if err := LOLTalentScout.Setup(g.options()); err != nil {
+		return err
+	}
+	if fs.NArg() == 0 {
+		printBundle("当前静态数据", staticdata.Current())
+		return nil
+	}
+	bundle, err := staticdata.Import(fs.Arg(0), *force)
+	if errors.Is(err, staticdata.ErrOlderVersion) {
+		return newUsageError("%v,需要覆盖时加 -force", err)
+	}
+	if err != nil {
+		return err
+	}
+	printBundle("已导入静态数据", bundle)
+	return nil
+}
+
+func printBundle(title string, b *staticdata.Bundle) {
+	fmt.Printf("%s 版本%s: 英雄%d 装备%d 符文%d 召唤师技能%d\n", title, b.Version, len(b.Champions), len(b.Items),
+		len(b.Runes), len(b.Spells))
+}
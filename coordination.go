@@ -0,0 +1,133 @@
+package LOLTalentScout
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"main.go/config"
+	"main.go/lcu"
+	"main.go/lcu/models"
+)
+
+const (
+	// coordMarkerPrefix 零宽字符，聊天室里看不到，用来区分伯乐发出的标记和玩家手打的消息
+	coordMarkerPrefix = "\u200b\u2060"
+	// coordMarker 就位标记，开黑时每个伯乐进入英雄选择后发一条，全部是零宽字符，队友在聊天室里看不到
+	coordMarker = coordMarkerPrefix + "\u200c\u200d\u200c"
+	// reportHeader 评分消息的标题，聊天室里出现该消息说明已经有伯乐发送了评分
+	reportHeader = "LOL伯乐正在寻找千里马..."
+	// coordPollInterval 等待其他伯乐发送评分时查看聊天室的间隔
+	coordPollInterval = time.Second
+)
+
+// announce 开黑时在英雄选择聊天室发出就位标记，返回发出的时间，未开启协商或单排时不发送，返回零值
+func (ts *TalentScout) announce(log *zap.Logger, conversationID string) time.Time {
+	if !config.Get().Coordination.Enabled {
+		return time.Time{}
+	}
+	//单排时队友都是路人，不需要协商
+	lobby, err := lcu.GetLobby()
+	if err != nil {
+		log.Warn("查询房间失败,不发送就位标记", zap.Error(err))
+		return time.Time{}
+	}
+	if len(lobby.Members) < 2 {
+		return time.Time{}
+	}
+	sendMessage(coordMarker, conversationID)
+	return time.Now()
+}
+
+// shouldSendReport 决定本机是否发送评分，没有发出就位标记(单排)时直接发送
+// 发出就位标记的伯乐按puuid排序，第一个直接发送，第n个等待n个takeoverMs，期间聊天室出现评分则只在本地显示
+func (ts *TalentScout) shouldSendReport(log *zap.Logger, conversationID string, announcedAt time.Time,
+	team map[int64]*models.Summoner) bool {
+	conf := config.Get().Coordination
+	if !conf.Enabled || announcedAt.IsZero() || ts.currSummoner == nil {
+		return true
+	}
+	//给其他伯乐留出发标记的时间
+	if !ts.wait(time.Until(announcedAt.Add(time.Duration(conf.ElectionMs) * time.Millisecond))) {
+		return false
+	}
+	msgList, err := lcu.ListConversationMsg(conversationID)
+	if err != nil {
+		log.Warn("读取聊天室失败,直接发送评分", zap.Error(err))
+		return true
+	}
+	if reportSent(msgList) {
+		fmt.Println("队友的伯乐已发送评分,本局评分只在本地显示")
+		return false
+	}
+	rank := coordRank(ts.currSummoner.Puuid, scoutSummonerIDs(msgList), team)
+	if rank == 0 {
+		return true
+	}
+	log.Info("队友也在使用伯乐,等待其发送评分", zap.Int("rank", rank))
+	deadline := time.Now().Add(time.Duration(rank*conf.TakeoverMs) * time.Millisecond)
+	for time.Now().Before(deadline) {
+		if !ts.wait(coordPollInterval) {
+			return false
+		}
+		msgList, err = lcu.ListConversationMsg(conversationID)
+		if err == nil && reportSent(msgList) {
+			fmt.Println("队友的伯乐已发送评分,本局评分只在本地显示")
+			return false
+		}
+	}
+	log.Info("队友的伯乐没有按时发送评分,由本机接替")
+	return true
+}
+
+// wait 等待一段时间，程序退出时返回false
+func (ts *TalentScout) wait(d time.Duration) bool {
+	if d <= 0 {
+		return ts.ctx.Err() == nil
+	}
+	select {
+	case <-ts.ctx.Done():
+		return false
+	case <-time.After(d):
+		return true
+	}
+}
+
+// scoutSummonerIDs 发过就位标记的召唤师id
+func scoutSummonerIDs(msgList []models.ConversationMsg) []int64 {
+	ids := make([]int64, 0, 5)
+	for _, msg := range msgList {
+		if strings.HasPrefix(msg.Body, coordMarkerPrefix) && msg.FromSummonerId > 0 {
+			ids = append(ids, msg.FromSummonerId)
+		}
+	}
+	return ids
+}
+
+// reportSent 聊天室里是否已经有伯乐发送了评分
+func reportSent(msgList []models.ConversationMsg) bool {
+	for _, msg := range msgList {
+		if strings.TrimPrefix(msg.Body, coordMarkerPrefix) == reportHeader {
+			return true
+		}
+	}
+	return false
+}
+
+// coordRank 本机在所有伯乐中按puuid排第几，0表示由本机发送，不在队伍里的召唤师不参与排序
+func coordRank(selfPuuid string, scoutIDs []int64, team map[int64]*models.Summoner) int {
+	rank := 0
+	seen := make(map[string]bool, len(scoutIDs))
+	for _, id := range scoutIDs {
+		summoner, ok := team[id]
+		if !ok || seen[summoner.Puuid] {
+			continue
+		}
+		seen[summoner.Puuid] = true
+		if summoner.Puuid < selfPuuid {
+			rank++
+		}
+	}
+	return rank
+}
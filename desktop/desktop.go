@@ -0,0 +1,40 @@
+package desktop
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrUnsupported 全局快捷键和剪贴板只支持Windows
+var ErrUnsupported = errors.New("当前系统不支持全局快捷键和剪贴板")
+
+// Modifier 快捷键的修饰键，可以组合
+type Modifier uint32
+
+// 和Windows的MOD_*取值一致
+const (
+	ModAlt   Modifier = 0x1
+	ModCtrl  Modifier = 0x2
+	ModShift Modifier = 0x4
+)
+
+// Hotkey 全局快捷键，Key为数字或大写字母
+type Hotkey struct {
+	Mods Modifier
+	Key  rune
+}
+
+// String 例如 Ctrl+Alt+1
+func (h Hotkey) String() string {
+	sb := strings.Builder{}
+	for _, m := range []struct {
+		mod  Modifier
+		name string
+	}{{ModCtrl, "Ctrl"}, {ModAlt, "Alt"}, {ModShift, "Shift"}} {
+		if h.Mods&m.mod != 0 {
+			sb.WriteString(m.name + "+")
+		}
+	}
+	sb.WriteRune(h.Key)
+	return sb.String()
+}
@@ -0,0 +1,15 @@
+//go:build !windows
+
+package desktop
+
+import "context"
+
+// ListenHotkeys 非Windows不支持全局快捷键
+func ListenHotkeys(ctx context.Context, keys []Hotkey, onPress func(i int)) error {
+	return ErrUnsupported
+}
+
+// SetClipboard 非Windows不支持剪贴板
+func SetClipboard(text string) error {
+	return ErrUnsupported
+}
@@ -0,0 +1,118 @@
+package desktop
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+const (
+	wmHotkey      = 0x0312
+	wmQuit        = 0x0012
+	modNoRepeat   = 0x4000
+	cfUnicodeText = 13
+	gmemMoveable  = 0x0002
+)
+
+var (
+	user32   = windows.NewLazySystemDLL("user32.dll")
+	kernel32 = windows.NewLazySystemDLL("kernel32.dll")
+
+	procRegisterHotKey    = user32.NewProc("RegisterHotKey")
+	procUnregisterHotKey  = user32.NewProc("UnregisterHotKey")
+	procGetMessage        = user32.NewProc("GetMessageW")
+	procPostThreadMessage = user32.NewProc("PostThreadMessageW")
+	procOpenClipboard     = user32.NewProc("OpenClipboard")
+	procCloseClipboard    = user32.NewProc("CloseClipboard")
+	procEmptyClipboard    = user32.NewProc("EmptyClipboard")
+	procSetClipboardData  = user32.NewProc("SetClipboardData")
+	procGlobalAlloc       = kernel32.NewProc("GlobalAlloc")
+	procGlobalFree        = kernel32.NewProc("GlobalFree")
+	procGlobalLock        = kernel32.NewProc("GlobalLock")
+	procGlobalUnlock      = kernel32.NewProc("GlobalUnlock")
+)
+
+// msg Windows的MSG结构
+type msg struct {
+	hwnd    uintptr
+	message uint32
+	wParam  uintptr
+	lParam  uintptr
+	time    uint32
+	pt      struct{ x, y int32 }
+}
+
+// ListenHotkeys 注册全局快捷键并阻塞等待，按下第i个快捷键时调用onPress(i)，ctx结束后注销并返回
+// 快捷键被其他程序占用时返回错误
+func ListenHotkeys(ctx context.Context, keys []Hotkey, onPress func(i int)) error {
+	// 快捷键消息发给注册的线程，必须在同一个线程上注册和收消息
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	threadID := windows.GetCurrentThreadId()
+	for i, key := range keys {
+		ok, _, err := procRegisterHotKey.Call(0, uintptr(i+1), uintptr(key.Mods|modNoRepeat), uintptr(key.Key))
+		if ok == 0 {
+			for j := range i {
+				_, _, _ = procUnregisterHotKey.Call(0, uintptr(j+1))
+			}
+			return fmt.Errorf("注册快捷键%s失败: %w", key, err)
+		}
+	}
+	defer func() {
+		for i := range keys {
+			_, _, _ = procUnregisterHotKey.Call(0, uintptr(i+1))
+		}
+	}()
+	stop := context.AfterFunc(ctx, func() {
+		_, _, _ = procPostThreadMessage.Call(uintptr(threadID), wmQuit, 0, 0)
+	})
+	defer stop()
+	m := msg{}
+	for {
+		ret, _, err := procGetMessage.Call(uintptr(unsafe.Pointer(&m)), 0, 0, 0)
+		switch int32(ret) {
+		case -1:
+			return err
+		case 0:
+			return nil
+		}
+		if m.message == wmHotkey && m.wParam >= 1 && int(m.wParam) <= len(keys) {
+			onPress(int(m.wParam) - 1)
+		}
+	}
+}
+
+// SetClipboard 把文字复制到剪贴板
+func SetClipboard(text string) error {
+	utf16, err := windows.UTF16FromString(text)
+	if err != nil {
+		return err
+	}
+	size := uintptr(len(utf16) * 2)
+	h, _, err := procGlobalAlloc.Call(gmemMoveable, size)
+	if h == 0 {
+		return err
+	}
+	p, _, err := procGlobalLock.Call(h)
+	if p == 0 {
+		_, _, _ = procGlobalFree.Call(h)
+		return err
+	}
+	copy(unsafe.Slice(*(**uint16)(unsafe.Pointer(&p)), len(utf16)), utf16)
+	_, _, _ = procGlobalUnlock.Call(h)
+	if ok, _, err := procOpenClipboard.Call(0); ok == 0 {
+		_, _, _ = procGlobalFree.Call(h)
+		return err
+	}
+	defer procCloseClipboard.Call()
+	_, _, _ = procEmptyClipboard.Call()
+	// 成功后内存归剪贴板所有，不能再释放
+	if ok, _, err := procSetClipboardData.Call(cfUnicodeText, h); ok == 0 {
+		_, _, _ = procGlobalFree.Call(h)
+		return err
+	}
+	return nil
+}
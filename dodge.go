@@ -0,0 +1,112 @@
+package LOLTalentScout
+
+import (
+	"fmt"
+	"go.uber.org/zap"
+	"main.go/config"
+	"main.go/lcu"
+	"main.go/scores"
+	"main.go/store"
+	"math"
+	"time"
+)
+
+const (
+	lobbyStoreKind = "lobby"   // 大厅记录目录
+	lobbyStoreKey  = "history" // 大厅历史记录文件
+)
+
+// LobbyRecord 英雄选择阶段的我方实力记录
+type LobbyRecord struct {
+	Time      time.Time `json:"time"`
+	AllyPower float64   `json:"allyPower"`
+	Players   int       `json:"players"`
+}
+
+// adviseDodge 英雄选择阶段把我方实力和平时的大厅比较，实力明显偏低时建议秒退
+func (ts *TalentScout) adviseDodge(log *zap.Logger, allies []*scores.UserScore) {
+	conf := config.Get()
+	if !conf.Dodge.Enabled || len(allies) == 0 {
+		return
+	}
+	allyPower := scores.TeamPower(allies, conf.WinRate)
+	history := appendLobbyRecord(log, LobbyRecord{
+		Time:      time.Now(),
+		AllyPower: allyPower,
+		Players:   len(allies),
+	}, conf.Dodge.HistorySize)
+	//不和当前大厅自己比较
+	history = history[:len(history)-1]
+	if len(history) < conf.Dodge.MinLobbies {
+		fmt.Printf("我方实力:%.1f,已记录%d个大厅,记录满%d个后给出秒退建议\n", allyPower, len(history),
+			conf.Dodge.MinLobbies)
+		return
+	}
+	mean, std := lobbyPowerMeanStd(history)
+	estimate := scores.EstimateAgainst(allyPower, mean, std, conf.WinRate)
+	fmt.Printf("我方实力:%.1f 平时大厅:%.1f±%.1f 相对平时%s\n", allyPower, mean, std, estimate)
+	if estimate.Probability >= conf.Dodge.Threshold {
+		return
+	}
+	msg := fmt.Sprintf("建议秒退:我方实力%.1f明显低于平时%.1f,相对平时%s", allyPower, mean, estimate)
+	fmt.Println(msg)
+	ts.ui.Notify(msg)
+	if !conf.Dodge.ConfirmToDodge {
+		return
+	}
+	timeout := time.Duration(conf.Dodge.ConfirmTimeoutSec) * time.Second
+	if !ts.ui.AskConfirm("确认秒退", timeout) {
+		fmt.Println("未确认秒退,继续对局")
+		return
+	}
+	if conf.Dodge.DryRun {
+		fmt.Println("[演练模式] 已确认秒退,未实际退出英雄选择")
+		return
+	}
+	if err := lcu.QuitChampSelect(); err != nil {
+		log.Error("秒退失败", zap.Error(err))
+		return
+	}
+	fmt.Println("已秒退")
+}
+
+// printWinEstimate 进入游戏后结合双方评分预估胜率
+func (ts *TalentScout) printWinEstimate(enemies []*scores.UserScore) {
+	ts.mu.Lock()
+	allies := ts.allyScores
+	// 只用于本局，避免下一局误用
+	ts.allyScores = nil
+	ts.mu.Unlock()
+	if len(allies) == 0 || len(enemies) == 0 {
+		return
+	}
+	estimate := scores.EstimateWinProbability(allies, enemies, config.Get().WinRate)
+	fmt.Printf("我方实力:%.1f 敌方实力:%.1f 预估%s\n", estimate.AllyPower, estimate.EnemyPower, estimate)
+}
+
+// appendLobbyRecord 追加一条大厅记录并返回最近的记录，从旧到新
+func appendLobbyRecord(log *zap.Logger, record LobbyRecord, historySize int) []LobbyRecord {
+	history := make([]LobbyRecord, 0, historySize+1)
+	_ = store.Load(lobbyStoreKind, lobbyStoreKey, &history)
+	history = append(history, record)
+	if historySize > 0 && len(history) > historySize+1 {
+		history = history[len(history)-historySize-1:]
+	}
+	if err := store.Save(lobbyStoreKind, lobbyStoreKey, history); err != nil {
+		log.Warn("保存大厅记录失败", zap.Error(err))
+	}
+	return history
+}
+
+func lobbyPowerMeanStd(history []LobbyRecord) (float64, float64) {
+	total := 0.0
+	for _, record := range history {
+		total += record.AllyPower
+	}
+	mean := total / float64(len(history))
+	variance := 0.0
+	for _, record := range history {
+		variance += (record.AllyPower - mean) * (record.AllyPower - mean)
+	}
+	return mean, math.Sqrt(variance / float64(len(history)))
+}
@@ -0,0 +1,79 @@
+package fit
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"main.go/config"
+	"main.go/scores"
+	"main.go/store"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+// Command 拟合子命令，用法: fit [-base a.yaml] [-out etc/calcScore.fit.yaml] [-min-hits 20]
+func Command(args []string) error {
+	fs := flag.NewFlagSet("fit", flag.ContinueOnError)
+	confPath := fs.String("config", config.DefaultPath, "配置文件路径")
+	basePath := fs.String("base", "", "作为起点的得分标准yaml文件，默认使用当前得分标准")
+	outPath := fs.String("out", "etc/calcScore.fit.yaml", "新得分标准输出路径")
+	opts := DefaultOptions()
+	fs.IntVar(&opts.MinHits, "min-hits", opts.MinHits, "加分项至少命中多少次才更新")
+	fs.Float64Var(&opts.TestRatio, "test-ratio", opts.TestRatio, "用于验证的最新对局比例")
+	fs.Float64Var(&opts.L2, "l2", opts.L2, "L2正则系数")
+	fs.IntVar(&opts.Iterations, "iterations", opts.Iterations, "梯度下降轮数")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := config.Init(*confPath); err != nil {
+		return err
+	}
+	store.Init(config.Get().DataDir)
+
+	base := scores.CurrCalcScoreConf()
+	if *basePath != "" {
+		var err error
+		if base, err = scores.LoadCalcScoreConf(*basePath); err != nil {
+			return fmt.Errorf("读取得分标准%s失败: %w", *basePath, err)
+		}
+	}
+	games, err := store.LoadGames()
+	if err != nil {
+		return err
+	}
+	if len(games) == 0 {
+		return errors.New("没有缓存的对局，先正常使用一段时间积累对局数据")
+	}
+	res, err := Fit(games, base, opts)
+	if err != nil {
+		return err
+	}
+	report := res.String()
+	fmt.Print(report)
+	if err = WriteConf(*outPath, res.Conf, report); err != nil {
+		return err
+	}
+	fmt.Println("新得分标准已写入", *outPath, "，可以用 backtest -conf", *outPath, "验证")
+	return nil
+}
+
+// WriteConf 把得分标准写成yaml，拟合报告作为文件头部注释
+func WriteConf(path string, calcScoreConf scores.CalcScoreConf, report string) error {
+	bts, err := yaml.Marshal(calcScoreConf)
+	if err != nil {
+		return err
+	}
+	sb := strings.Builder{}
+	sb.WriteString("# 离线拟合生成的得分标准\n")
+	for _, line := range strings.Split(strings.TrimRight(report, "\n"), "\n") {
+		sb.WriteString("# " + line + "\n")
+	}
+	sb.Write(bts)
+	if err = os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, []byte(sb.String()), 0o644)
+}
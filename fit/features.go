@@ -0,0 +1,130 @@
+package fit
+
+import (
+	"fmt"
+	"main.go/lcu/models"
+	"main.go/scores"
+	"slices"
+)
+
+// slot 得分标准里的一个加分项
+type slot struct {
+	name string
+	get  func(c *scores.CalcScoreConf) *float64
+}
+
+// listSlots 列出得分标准里全部加分项，补兵和占比类加分项的个数取决于得分标准本身
+func listSlots(c scores.CalcScoreConf) []slot {
+	slots := []slot{
+		{"firstBlood[0] 一血击杀", func(c *scores.CalcScoreConf) *float64 { return &c.FirstBlood[0] }},
+		{"firstBlood[1] 一血助攻", func(c *scores.CalcScoreConf) *float64 { return &c.FirstBlood[1] }},
+		{"pentaKills[0] 五杀", func(c *scores.CalcScoreConf) *float64 { return &c.PentaKills[0] }},
+		{"quadraKills[0] 四杀", func(c *scores.CalcScoreConf) *float64 { return &c.QuadraKills[0] }},
+		{"tripleKills[0] 三杀", func(c *scores.CalcScoreConf) *float64 { return &c.TripleKills[0] }},
+	}
+	rankSlots := []struct {
+		name string
+		desc string
+		get  func(c *scores.CalcScoreConf) []float64
+		size int
+	}{
+		{"joinTeamRate", "参团率排名", func(c *scores.CalcScoreConf) []float64 { return c.JoinTeamRateRank[:] }, 4},
+		{"goldEarned", "打钱排名", func(c *scores.CalcScoreConf) []float64 { return c.GoldEarnedRank[:] }, 4},
+		{"hurtRank", "伤害排名", func(c *scores.CalcScoreConf) []float64 { return c.HurtRank[:] }, 2},
+		{"money2HurtRateRank", "金钱转换伤害比排名", func(c *scores.CalcScoreConf) []float64 { return c.Money2hurtRateRank[:] }, 2},
+		{"visionScoreRank", "视野得分排名", func(c *scores.CalcScoreConf) []float64 { return c.VisionScoreRank[:] }, 2},
+	}
+	for _, item := range rankSlots {
+		for i := 0; i < item.size; i++ {
+			get := item.get
+			slots = append(slots, slot{
+				name: fmt.Sprintf("%s[%d] %s", item.name, i, item.desc),
+				get:  func(c *scores.CalcScoreConf) *float64 { return &get(c)[i] },
+			})
+		}
+	}
+	for i := range c.MinionsKilled {
+		slots = append(slots, slot{
+			name: fmt.Sprintf("minionsKilled[%d][1] 补兵", i),
+			get:  func(c *scores.CalcScoreConf) *float64 { return &c.MinionsKilled[i][1] },
+		})
+	}
+	rateSlots := []struct {
+		name string
+		desc string
+		get  func(c *scores.CalcScoreConf) []scores.RateItemConf
+	}{
+		{"killRate", "击杀占比", func(c *scores.CalcScoreConf) []scores.RateItemConf { return c.KillRate }},
+		{"hurtRate", "伤害占比", func(c *scores.CalcScoreConf) []scores.RateItemConf { return c.HurtRate }},
+		{"assistRate", "助攻占比", func(c *scores.CalcScoreConf) []scores.RateItemConf { return c.AssistRate }},
+	}
+	for _, item := range rateSlots {
+		for i, rateItem := range item.get(&c) {
+			for j := range rateItem.ScoreConf {
+				get := item.get
+				slots = append(slots, slot{
+					name: fmt.Sprintf("%s[%d].scoreConf[%d][1] %s", item.name, i, j, item.desc),
+					get:  func(c *scores.CalcScoreConf) *float64 { return &get(c)[i].ScoreConf[j][1] },
+				})
+			}
+		}
+	}
+	return slots
+}
+
+// cloneConf 深拷贝得分标准，修改加分项时不影响原得分标准
+func cloneConf(c scores.CalcScoreConf) scores.CalcScoreConf {
+	res := c
+	res.MinionsKilled = slices.Clone(c.MinionsKilled)
+	cloneRate := func(list []scores.RateItemConf) []scores.RateItemConf {
+		list = slices.Clone(list)
+		for i := range list {
+			list[i].ScoreConf = slices.Clone(list[i].ScoreConf)
+		}
+		return list
+	}
+	res.KillRate = cloneRate(c.KillRate)
+	res.HurtRate = cloneRate(c.HurtRate)
+	res.AssistRate = cloneRate(c.AssistRate)
+	return res
+}
+
+// extractor 提取单局特征：把某一个加分项设为1其他设为0再算分，得分变化就是该项的命中情况(扣分项为-1)，
+// 和实时评分走同一套算分逻辑，不用重复维护规则
+type extractor struct {
+	slots []slot
+	zero  scores.RuleSet   // 所有加分项为0，只剩kda微调
+	units []scores.RuleSet // 第i套规则只有第i个加分项为1
+}
+
+func newExtractor(base scores.CalcScoreConf) *extractor {
+	e := &extractor{slots: listSlots(base)}
+	zero := cloneConf(base)
+	for _, s := range e.slots {
+		*s.get(&zero) = 0
+	}
+	e.zero = scores.ConfRules(zero)
+	for _, s := range e.slots {
+		unit := cloneConf(zero)
+		*s.get(&unit) = 1
+		e.units = append(e.units, scores.ConfRules(unit))
+	}
+	return e
+}
+
+// features 返回各加分项的命中情况和只有kda微调时的单局得分
+func (e *extractor) features(participantID int, gameSummary models.GameSummary) ([]float64, float64, error) {
+	zeroScore, err := scores.CalcParticipantGameScoreWithRules(participantID, gameSummary, e.zero)
+	if err != nil {
+		return nil, 0, err
+	}
+	x := make([]float64, len(e.units))
+	for i, unit := range e.units {
+		score, err := scores.CalcParticipantGameScoreWithRules(participantID, gameSummary, unit)
+		if err != nil {
+			return nil, 0, err
+		}
+		x[i] = score.Value() - zeroScore.Value()
+	}
+	return x, zeroScore.Value(), nil
+}
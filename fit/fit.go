@@ -0,0 +1,209 @@
+package fit
+
+import (
+	"fmt"
+	"main.go/lcu/models"
+	"main.go/scores"
+	"math"
+	"slices"
+	"strings"
+)
+
+type (
+	// Options 拟合参数
+	Options struct {
+		MinGameDurationSec int     // 短于该时长的对局不参与拟合
+		MinHits            int     // 加分项命中和未命中都至少达到该次数才更新，否则保留原值
+		TestRatio          float64 // 按时间划分，最新的这部分对局用于验证
+		L2                 float64 // L2正则系数
+		Iterations         int     // 梯度下降轮数
+	}
+	// SlotResult 一个加分项的拟合结果
+	SlotResult struct {
+		Name     string  `json:"name"`
+		Hits     int     `json:"hits"`     // 训练集命中次数
+		Coef     float64 `json:"coef"`     // 逻辑回归系数，命中一次胜率对数几率的变化
+		OldValue float64 `json:"oldValue"` // 原得分
+		NewValue float64 `json:"newValue"` // 新得分
+		Updated  bool    `json:"updated"`  // 命中次数不足时保留原值
+	}
+	// Eval 单局得分对胜负的区分度
+	Eval struct {
+		LogLoss  float64 `json:"logLoss"`
+		Accuracy float64 `json:"accuracy"`
+	}
+	// Result 拟合结果
+	Result struct {
+		Games         int                  `json:"games"`
+		TrainSamples  int                  `json:"trainSamples"`
+		TestSamples   int                  `json:"testSamples"`
+		PointPerLogit float64              `json:"pointPerLogit"` // 系数换算成得分的比例，保持总分值和原得分标准一致
+		KDACoef       float64              `json:"kdaCoef"`       // kda微调每1分的系数，kda参数不是线性权重，不更新
+		Slots         []SlotResult         `json:"slots"`
+		OldTest       Eval                 `json:"oldTest"` // 原得分标准在验证集上的表现
+		NewTest       Eval                 `json:"newTest"` // 新得分标准在验证集上的表现
+		Conf          scores.CalcScoreConf `json:"-"`
+	}
+)
+
+// DefaultOptions 默认拟合参数
+func DefaultOptions() Options {
+	return Options{
+		MinGameDurationSec: 15 * 60,
+		MinHits:            20,
+		TestRatio:          0.2,
+		L2:                 1e-3,
+		Iterations:         2000,
+	}
+}
+
+// sample 一名玩家在一局中的特征
+type sample struct {
+	x   []float64 // 各加分项命中情况
+	kda float64   // 只有kda微调时的单局得分
+	win float64
+}
+
+// Fit 在缓存对局上拟合得分标准：每名玩家每局的加分项命中情况作为特征，该局胜负作为标签训练逻辑回归，
+// 再把系数按原得分标准的总分值换算成新的加分
+func Fit(games []models.GameSummary, base scores.CalcScoreConf, opts Options) (*Result, error) {
+	list := make([]models.GameSummary, 0, len(games))
+	for _, game := range games {
+		if slices.Contains(scores.ScoreQueueIDs, models.GameQueueID(game.QueueId)) &&
+			game.GameDuration >= opts.MinGameDurationSec {
+			list = append(list, game)
+		}
+	}
+	if len(list) == 0 {
+		return nil, fmt.Errorf("没有可用于拟合的对局")
+	}
+	slices.SortFunc(list, func(a, b models.GameSummary) int {
+		return a.GameCreationDate.Compare(b.GameCreationDate)
+	})
+	e := newExtractor(base)
+	trainGames := len(list) - int(float64(len(list))*opts.TestRatio)
+	train := make([]sample, 0, trainGames*10)
+	test := make([]sample, 0, (len(list)-trainGames)*10)
+	for i, game := range list {
+		for _, participant := range game.Participants {
+			x, kda, err := e.features(participant.ParticipantId, game)
+			if err != nil {
+				continue
+			}
+			item := sample{x: x, kda: kda}
+			if participant.Stats.Win {
+				item.win = 1
+			}
+			if i < trainGames {
+				train = append(train, item)
+			} else {
+				test = append(test, item)
+			}
+		}
+	}
+	if len(train) == 0 {
+		return nil, fmt.Errorf("没有可用于拟合的样本")
+	}
+
+	trainX, trainY := toMatrix(train)
+	model := trainLogistic(trainX, trainY, opts.L2, opts.Iterations)
+	res := &Result{
+		Games:        len(list),
+		TrainSamples: len(train),
+		TestSamples:  len(test),
+		KDACoef:      model.weights[len(model.weights)-1],
+		Conf:         cloneConf(base),
+	}
+	// 按命中过的加分项的总分值换算，保持评分尺度不变，马匹评级的阈值依旧可用
+	oldTotal, coefTotal := 0.0, 0.0
+	for i, s := range e.slots {
+		hits := 0
+		for _, item := range train {
+			if item.x[i] != 0 {
+				hits++
+			}
+		}
+		slotRes := SlotResult{
+			Name:     s.name,
+			Hits:     hits,
+			Coef:     model.weights[i+1],
+			OldValue: *s.get(&res.Conf),
+		}
+		slotRes.NewValue = slotRes.OldValue
+		// 每局都命中的加分项和截距无法区分，同样保留原值
+		slotRes.Updated = hits >= max(opts.MinHits, 1) && len(train)-hits >= max(opts.MinHits, 1)
+		if slotRes.Updated {
+			oldTotal += math.Abs(slotRes.OldValue)
+			coefTotal += math.Abs(slotRes.Coef)
+		}
+		res.Slots = append(res.Slots, slotRes)
+	}
+	if coefTotal > 0 {
+		res.PointPerLogit = oldTotal / coefTotal
+	}
+	for i, s := range e.slots {
+		if !res.Slots[i].Updated || res.PointPerLogit == 0 {
+			res.Slots[i].Updated = false
+			continue
+		}
+		// 保留0.5分精度
+		res.Slots[i].NewValue = math.Round(res.Slots[i].Coef*res.PointPerLogit*2) / 2
+		*s.get(&res.Conf) = res.Slots[i].NewValue
+	}
+
+	// 用单局得分作为唯一特征，比较新旧得分标准对胜负的区分度
+	res.OldTest = evalGameScore(train, test, res.Slots, func(s SlotResult) float64 { return s.OldValue }, opts)
+	res.NewTest = evalGameScore(train, test, res.Slots, func(s SlotResult) float64 { return s.NewValue }, opts)
+	return res, nil
+}
+
+// toMatrix 样本转成特征矩阵，最后一列是kda微调得分
+func toMatrix(samples []sample) ([][]float64, []float64) {
+	x := make([][]float64, 0, len(samples))
+	y := make([]float64, 0, len(samples))
+	for _, item := range samples {
+		x = append(x, append(slices.Clone(item.x), item.kda))
+		y = append(y, item.win)
+	}
+	return x, y
+}
+
+// evalGameScore 按某个得分标准算出单局得分，在训练集上拟合得分和胜负的关系，在验证集上评估
+func evalGameScore(train, test []sample, slots []SlotResult, value func(SlotResult) float64, opts Options) Eval {
+	gameScore := func(samples []sample) ([][]float64, []float64) {
+		x := make([][]float64, 0, len(samples))
+		y := make([]float64, 0, len(samples))
+		for _, item := range samples {
+			score := item.kda
+			for i, hit := range item.x {
+				score += hit * value(slots[i])
+			}
+			x = append(x, []float64{score})
+			y = append(y, item.win)
+		}
+		return x, y
+	}
+	trainX, trainY := gameScore(train)
+	model := trainLogistic(trainX, trainY, 0, opts.Iterations)
+	testX, testY := gameScore(test)
+	logLoss, accuracy := model.evaluate(testX, testY)
+	return Eval{LogLoss: logLoss, Accuracy: accuracy}
+}
+
+// String 拟合报告文本
+func (r *Result) String() string {
+	sb := strings.Builder{}
+	sb.WriteString(fmt.Sprintf("对局:%d 训练样本:%d 验证样本:%d\n", r.Games, r.TrainSamples, r.TestSamples))
+	sb.WriteString(fmt.Sprintf("验证集单局得分预测胜负 原得分标准: 对数损失%.4f 准确率%.1f%% | 新得分标准: 对数损失%.4f 准确率%.1f%%\n",
+		r.OldTest.LogLoss, r.OldTest.Accuracy*100, r.NewTest.LogLoss, r.NewTest.Accuracy*100))
+	sb.WriteString(fmt.Sprintf("系数换算比例:%.2f分 kda微调系数:%.4f(不更新)\n", r.PointPerLogit, r.KDACoef))
+	sb.WriteString("加分项(命中次数 系数 原得分 -> 新得分):\n")
+	for _, s := range r.Slots {
+		mark := ""
+		if !s.Updated {
+			mark = " 样本不足,保留原值"
+		}
+		sb.WriteString(fmt.Sprintf("  %s\t%d\t%.4f\t%.1f -> %.1f%s\n", s.Name, s.Hits, s.Coef, s.OldValue, s.NewValue, mark))
+	}
+	return sb.String()
+}
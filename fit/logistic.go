@@ -0,0 +1,102 @@
+package fit
+
+import "math"
+
+// logisticModel 逻辑回归，weights[0]为截距
+type logisticModel struct {
+	weights []float64
+}
+
+// trainLogistic 全量梯度下降训练逻辑回归，训练前对每列标准化，返回原始尺度下的权重；
+// 没有变化的列(从未命中的加分项)权重为0
+func trainLogistic(x [][]float64, y []float64, l2 float64, iterations int) logisticModel {
+	if len(x) == 0 {
+		return logisticModel{}
+	}
+	dim := len(x[0])
+	means := make([]float64, dim)
+	stds := make([]float64, dim)
+	for _, row := range x {
+		for j, v := range row {
+			means[j] += v
+		}
+	}
+	for j := range means {
+		means[j] /= float64(len(x))
+	}
+	for _, row := range x {
+		for j, v := range row {
+			stds[j] += (v - means[j]) * (v - means[j])
+		}
+	}
+	for j := range stds {
+		stds[j] = math.Sqrt(stds[j] / float64(len(x)))
+	}
+	standardize := func(row []float64, j int) float64 {
+		if stds[j] == 0 {
+			return 0
+		}
+		return (row[j] - means[j]) / stds[j]
+	}
+
+	w := make([]float64, dim+1)
+	grad := make([]float64, dim+1)
+	const learningRate = 0.5
+	for iter := 0; iter < iterations; iter++ {
+		clear(grad)
+		for i, row := range x {
+			z := w[0]
+			for j := range row {
+				z += w[j+1] * standardize(row, j)
+			}
+			diff := sigmoid(z) - y[i]
+			grad[0] += diff
+			for j := range row {
+				grad[j+1] += diff * standardize(row, j)
+			}
+		}
+		w[0] -= learningRate * grad[0] / float64(len(x))
+		for j := 1; j <= dim; j++ {
+			w[j] -= learningRate * (grad[j]/float64(len(x)) + l2*w[j])
+		}
+	}
+	// 换算回原始尺度
+	model := logisticModel{weights: make([]float64, dim+1)}
+	model.weights[0] = w[0]
+	for j := 0; j < dim; j++ {
+		if stds[j] == 0 {
+			continue
+		}
+		model.weights[j+1] = w[j+1] / stds[j]
+		model.weights[0] -= w[j+1] * means[j] / stds[j]
+	}
+	return model
+}
+
+func (m logisticModel) predict(row []float64) float64 {
+	z := m.weights[0]
+	for j, v := range row {
+		z += m.weights[j+1] * v
+	}
+	return sigmoid(z)
+}
+
+// evaluate 对数损失和准确率
+func (m logisticModel) evaluate(x [][]float64, y []float64) (float64, float64) {
+	if len(x) == 0 || len(m.weights) == 0 {
+		return 0, 0
+	}
+	logLoss, correct := 0.0, 0
+	for i, row := range x {
+		p := min(max(m.predict(row), 1e-6), 1-1e-6)
+		logLoss -= y[i]*math.Log(p) + (1-y[i])*math.Log(1-p)
+		if (p >= .5) == (y[i] == 1) {
+			correct++
+		}
+	}
+	return logLoss / float64(len(x)), float64(correct) / float64(len(x))
+}
+
+func sigmoid(z float64) float64 {
+	return 1 / (1 + math.Exp(-z))
+}
@@ -0,0 +1,46 @@
+//go:build !windows
+
+package initialize
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// 非Windows下客户端一般跑在wine里，从/proc读取客户端进程的启动参数
+const lolUxProcessName = "LeagueClientUx.exe"
+
+var (
+	lolTokenReg = regexp.MustCompile(`--remoting-auth-token=(\S+)`)
+	lolPortReg  = regexp.MustCompile(`--app-port=(\d+)`)
+)
+
+// NewCertificate 获取客户端的token和端口，找不到客户端进程时返回空值
+func NewCertificate() (token string, port int) {
+	dirs, err := filepath.Glob("/proc/[0-9]*")
+	if err != nil {
+		return
+	}
+	for _, dir := range dirs {
+		bts, err := os.ReadFile(filepath.Join(dir, "cmdline"))
+		if err != nil {
+			continue
+		}
+		// cmdline中各个参数以\0分隔
+		cmdLine := strings.ReplaceAll(string(bts), "\x00", " ")
+		if !strings.Contains(cmdLine, lolUxProcessName) {
+			continue
+		}
+		tokenChunk := lolTokenReg.FindStringSubmatch(cmdLine)
+		portChunk := lolPortReg.FindStringSubmatch(cmdLine)
+		if len(tokenChunk) < 2 || len(portChunk) < 2 {
+			continue
+		}
+		port, _ = strconv.Atoi(portChunk[1])
+		return tokenChunk[1], port
+	}
+	return
+}
@@ -3,7 +3,9 @@ package initialize
 import (
 	"errors"
 	"fmt"
+	"go.uber.org/zap"
 	"golang.org/x/sys/windows"
+	"main.go/logger"
 	"os/exec"
 	"regexp"
 	"strconv"
@@ -106,12 +108,12 @@ func NewCertificate() (token string, port int) {
 	}
 	cmdLine, err := GetProcessCommandLine(uint32(pids[0]))
 	if err != nil {
-		fmt.Printf("无法获取进程命令行: %v\n", err)
+		logger.L().Warn("无法获取进程命令行", zap.Error(err))
 		return
 	}
 	btsChunk := lolCommandlineReg.FindSubmatch([]byte(cmdLine))
 	if len(btsChunk) < 3 {
-		fmt.Println("格式错误")
+		logger.L().Warn("客户端进程命令行格式错误")
 		return
 	}
 	token = string(btsChunk[1])
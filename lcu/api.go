@@ -37,7 +37,7 @@ func GetCurrSummoner() (*models.CurrSummoner, error) {
 	data := &models.CurrSummoner{}
 	err = json.Unmarshal(bts, data)
 	if err != nil {
-		fmt.Println("获取当前召唤师失败", zap.Error(err))
+		logger.Error("获取当前召唤师失败", zap.Error(err))
 		return nil, err
 	}
 	if data.SummonerId == 0 {
@@ -56,7 +56,7 @@ func ListGamesBySummonerID(summonerID int64, begin, limit int) (*models.GameList
 	data := &models.GameListResp{}
 	err = json.Unmarshal(bts, data)
 	if err != nil {
-		fmt.Println("获取比赛记录", zap.Error(err))
+		logger.Error("获取比赛记录", zap.Error(err))
 		return nil, err
 	}
 	return data, nil
@@ -72,7 +72,7 @@ func ListGamesByPUUID(puuid string, begin, limit int) (*models.GameListResp, err
 	data := &models.GameListResp{}
 	err = json.Unmarshal(bts, data)
 	if err != nil {
-		fmt.Println("获取比赛记录", zap.Error(err))
+		logger.Error("获取比赛记录", zap.Error(err))
 		return nil, err
 	}
 	return data, nil
@@ -87,7 +87,7 @@ func ListConversationMsg(conversationID string) ([]models.ConversationMsg, error
 	list := make([]models.ConversationMsg, 0, 10)
 	err = json.Unmarshal(bts, &list)
 	if err != nil {
-		fmt.Println("获取会话组消息记录失败", zap.Error(err))
+		logger.Error("获取会话组消息记录失败", zap.Error(err))
 		return nil, err
 	}
 	return list, nil
@@ -95,6 +95,30 @@ func ListConversationMsg(conversationID string) ([]models.ConversationMsg, error
 
 // GetCurrConversationID 获取当前对局聊天组
 func GetCurrConversationID() (string, error) {
+	conversationID, err := GetConversationIDByType(models.GameStatusChampionSelect)
+	if err != nil {
+		return "", err
+	}
+	if conversationID == "" {
+		return "", errors.New("当前不在英雄选择阶段")
+	}
+	return conversationID, nil
+}
+
+// GetPostGameConversationID 获取结算房间聊天组
+func GetPostGameConversationID() (string, error) {
+	conversationID, err := GetConversationIDByType(models.GameStatusPostGame)
+	if err != nil {
+		return "", err
+	}
+	if conversationID == "" {
+		return "", errors.New("当前不在结算房间")
+	}
+	return conversationID, nil
+}
+
+// GetConversationIDByType 根据类型获取聊天组id，不存在时返回空字符串
+func GetConversationIDByType(conversationType models.GameStatus) (string, error) {
 	bts, err := cli.httpGet("/lol-chat/v1/conversations")
 	if err != nil {
 		return "", err
@@ -102,15 +126,15 @@ func GetCurrConversationID() (string, error) {
 	list := make([]models.Conversation, 0, 1)
 	err = json.Unmarshal(bts, &list)
 	if err != nil {
-		fmt.Println("获取当前对局聊天组失败", zap.Error(err))
+		logger.Error("获取聊天组失败", zap.Error(err))
 		return "", err
 	}
 	for _, conversation := range list {
-		if conversation.Type == models.GameStatusChampionSelect {
+		if conversation.Type == conversationType {
 			return conversation.Id, nil
 		}
 	}
-	return "", errors.New("当前不在英雄选择阶段")
+	return "", nil
 }
 
 // SendConversationMsg 发送消息到聊天组
@@ -155,7 +179,7 @@ func ListSummoner(summonerIDList []int64) ([]models.Summoner, error) {
 		list := make([]models.Summoner, 0, len(summonerIDList))
 		err = json.Unmarshal(bts, &list)
 		if err != nil {
-			fmt.Println("查询用户信息失败", zap.Error(err))
+			logger.Error("查询用户信息失败", zap.Error(err))
 			return nil, err
 		}
 		return list, err
@@ -163,7 +187,7 @@ func ListSummoner(summonerIDList []int64) ([]models.Summoner, error) {
 	data := &models.CommonResp{}
 	err = json.Unmarshal(bts, data)
 	if err != nil {
-		fmt.Println("查询用户信息失败", zap.Error(err))
+		logger.Error("查询用户信息失败", zap.Error(err))
 		return nil, err
 	}
 	return nil, errors.New(data.Message)
@@ -191,7 +215,7 @@ func QueryGameSummary(gameID int64) (*models.GameSummary, error) {
 	data := &models.GameSummary{}
 	err = json.Unmarshal(bts, data)
 	if err != nil {
-		fmt.Println("查询对局详情失败", zap.Error(err))
+		logger.Error("查询对局详情失败", zap.Error(err))
 		return nil, err
 	}
 	if data.CommonResp.ErrorCode != "" {
@@ -209,7 +233,7 @@ func QuerySummonerByName(name string) (*models.Summoner, error) {
 	data := &models.Summoner{}
 	err = json.Unmarshal(bts, data)
 	if err != nil {
-		fmt.Println("搜索用户失败", zap.Error(err))
+		logger.Error("搜索用户失败", zap.Error(err))
 		return nil, err
 	}
 	if data.CommonResp.ErrorCode != "" {
@@ -218,6 +242,24 @@ func QuerySummonerByName(name string) (*models.Summoner, error) {
 	return data, nil
 }
 
+// GetRankedStats 查询排位信息
+func GetRankedStats(puuid string) (*models.RankedStats, error) {
+	bts, err := cli.httpGet(fmt.Sprintf("/lol-ranked/v1/ranked-stats/%s", puuid))
+	if err != nil {
+		return nil, err
+	}
+	data := &models.RankedStats{}
+	err = json.Unmarshal(bts, data)
+	if err != nil {
+		logger.Error("查询排位信息失败", zap.Error(err))
+		return nil, err
+	}
+	if data.CommonResp.ErrorCode != "" {
+		return nil, errors.New(fmt.Sprintf("查询排位信息失败 :%s", data.CommonResp.Message))
+	}
+	return data, nil
+}
+
 // AcceptGame 接受对局
 func AcceptGame() error {
 	_, err := cli.httpPost("/lol-matchmaking/v1/ready-check/accept", nil)
@@ -233,7 +275,7 @@ func GetChampSelectSession() (*models.ChampSelectSessionInfo, error) {
 	data := &models.ChampSelectSessionInfo{}
 	err = json.Unmarshal(bts, data)
 	if err != nil {
-		fmt.Println("查询选人会话详情失败", zap.Error(err))
+		logger.Error("查询选人会话详情失败", zap.Error(err))
 		return nil, err
 	}
 	if data.CommonResp.ErrorCode != "" {
@@ -263,7 +305,7 @@ func ChampSelectPatchAction(championID, actionID int, patchType *models.ChampSel
 	data := &models.CommonResp{}
 	err = json.Unmarshal(bts, data)
 	if err != nil {
-		fmt.Println("ChampSelectPatchAction详情失败", zap.Error(err), zap.Any("completed", completed),
+		logger.Error("ChampSelectPatchAction详情失败", zap.Error(err), zap.Any("completed", completed),
 			zap.Any("patchType", patchType), zap.Int("championID", championID), zap.ByteString("bts", bts))
 		return err
 	}
@@ -296,6 +338,13 @@ func BanChampion(championID, actionID int) error {
 	return ChampSelectPatchAction(championID, actionID, patchType, completed)
 }
 
+// QuitChampSelect 退出英雄选择(秒退)，会受到秒退惩罚
+func QuitChampSelect() error {
+	_, err := cli.httpPost(`/lol-login/v1/session/invoke?destination=lcdsServiceProxy&method=call&args=`+
+		url.QueryEscape(`["","teambuilder-draft","quitV2",""]`), nil)
+	return err
+}
+
 // QueryGameFlowSession 查询游戏会话
 func QueryGameFlowSession() (*models.GameFlowSession, error) {
 	bts, err := cli.httpGet("/lol-gameflow/v1/session")
@@ -305,7 +354,7 @@ func QueryGameFlowSession() (*models.GameFlowSession, error) {
 	data := &models.GameFlowSession{}
 	err = json.Unmarshal(bts, data)
 	if err != nil {
-		fmt.Println("查询游戏会话失败", zap.Error(err))
+		logger.Error("查询游戏会话失败", zap.Error(err))
 		return nil, err
 	}
 	if data.CommonResp.ErrorCode != "" {
@@ -319,3 +368,136 @@ func UpdateSummonerProfile(data models.UpdateSummonerProfileData) error {
 	_, err := cli.req(http.MethodPut, "/lol-chat/v1/me", data)
 	return err
 }
+
+// ListPerkPages 获取全部符文页，包括不能编辑的默认符文页
+func ListPerkPages() ([]models.PerkPage, error) {
+	bts, err := cli.httpGet("/lol-perks/v1/pages")
+	if err != nil {
+		return nil, err
+	}
+	var pages []models.PerkPage
+	if err = json.Unmarshal(bts, &pages); err != nil {
+		if respErr := parseCommonResp(bts); respErr != nil {
+			err = respErr
+		}
+		return nil, errors.Wrap(err, "查询符文页失败")
+	}
+	return pages, nil
+}
+
+// GetPerkInventory 获取可以自定义的符文页数
+func GetPerkInventory() (*models.PerkInventory, error) {
+	bts, err := cli.httpGet("/lol-perks/v1/inventory")
+	if err != nil {
+		return nil, err
+	}
+	data := &models.PerkInventory{}
+	if err = json.Unmarshal(bts, data); err != nil {
+		return nil, err
+	}
+	if data.CommonResp.ErrorCode != "" {
+		return nil, errors.New(fmt.Sprintf("查询符文页数量失败 :%s", data.CommonResp.Message))
+	}
+	return data, nil
+}
+
+// GetLobby 查询当前房间
+func GetLobby() (*models.Lobby, error) {
+	bts, err := cli.httpGet("/lol-lobby/v2/lobby")
+	if err != nil {
+		return nil, err
+	}
+	data := &models.Lobby{}
+	if err = json.Unmarshal(bts, data); err != nil {
+		return nil, err
+	}
+	if data.CommonResp.ErrorCode != "" {
+		return nil, errors.New(fmt.Sprintf("查询房间失败 :%s", data.CommonResp.Message))
+	}
+	return data, nil
+}
+
+// CreatePerkPage 新建符文页
+func CreatePerkPage(page models.PerkPage) (*models.PerkPage, error) {
+	page.Id = 0
+	bts, err := cli.httpPost("/lol-perks/v1/pages", page)
+	if err != nil {
+		return nil, err
+	}
+	if err = parseCommonResp(bts); err != nil {
+		return nil, errors.Wrap(err, "新建符文页失败")
+	}
+	created := &models.PerkPage{}
+	if err = json.Unmarshal(bts, created); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// UpdatePerkPage 修改符文页，page.Id为要修改的符文页
+func UpdatePerkPage(page models.PerkPage) error {
+	bts, err := cli.req(http.MethodPut, fmt.Sprintf("/lol-perks/v1/pages/%d", page.Id), page)
+	if err != nil {
+		return err
+	}
+	return errors.Wrap(parseCommonResp(bts), "修改符文页失败")
+}
+
+// SetCurrentPerkPage 切换当前使用的符文页
+func SetCurrentPerkPage(pageID int64) error {
+	bts, err := cli.req(http.MethodPut, "/lol-perks/v1/currentpage", pageID)
+	if err != nil {
+		return err
+	}
+	return errors.Wrap(parseCommonResp(bts), "切换符文页失败")
+}
+
+// parseCommonResp 接口返回错误信息时转成error，成功或没有返回内容时为nil
+func parseCommonResp(bts []byte) error {
+	data := &models.CommonResp{}
+	if len(bts) == 0 || json.Unmarshal(bts, data) != nil || data.ErrorCode == "" {
+		return nil
+	}
+	return errors.New(data.Message)
+}
+
+// UpdateMySelection 修改自己在英雄选择阶段的召唤师技能
+func UpdateMySelection(spell1ID, spell2ID models.Spell) error {
+	body := struct {
+		Spell1Id models.Spell `json:"spell1Id"`
+		Spell2Id models.Spell `json:"spell2Id"`
+	}{
+		Spell1Id: spell1ID,
+		Spell2Id: spell2ID,
+	}
+	bts, err := cli.httpPatch("/lol-champ-select/v1/session/my-selection", body)
+	if err != nil {
+		return err
+	}
+	return errors.Wrap(parseCommonResp(bts), "修改召唤师技能失败")
+}
+
+// GetItemSets 获取召唤师的全部装备方案
+func GetItemSets(summonerID int64) (*models.ItemSets, error) {
+	bts, err := cli.httpGet(fmt.Sprintf("/lol-item-sets/v1/item-sets/%d/sets", summonerID))
+	if err != nil {
+		return nil, err
+	}
+	if err = parseCommonResp(bts); err != nil {
+		return nil, errors.Wrap(err, "查询装备方案失败")
+	}
+	data := &models.ItemSets{}
+	if err = json.Unmarshal(bts, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// SaveItemSets 保存召唤师的全部装备方案，会覆盖客户端现有的方案
+func SaveItemSets(summonerID int64, sets models.ItemSets) error {
+	bts, err := cli.req(http.MethodPut, fmt.Sprintf("/lol-item-sets/v1/item-sets/%d/sets", summonerID), sets)
+	if err != nil {
+		return err
+	}
+	return errors.Wrap(parseCommonResp(bts), "保存装备方案失败")
+}
@@ -5,8 +5,14 @@ import (
 	"crypto/tls"
 	"encoding/json"
 	"fmt"
+	"go.uber.org/zap"
 	"io"
+	"main.go/metrics"
 	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
 )
 
 var (
@@ -20,8 +26,22 @@ var (
 	}
 
 	cli *client
+
+	logger = zap.NewNop()
+
+	requestDuration = metrics.NewHistogram("lcu_request_duration_seconds", "客户端接口耗时",
+		metrics.LatencyBuckets, "method", "endpoint")
+	requestErrors = metrics.NewCounter("lcu_request_errors_total", "客户端接口失败次数，reason为transport或状态码",
+		"method", "endpoint", "reason")
+	// 路径中的召唤师id、puuid、对局id、聊天室id等替换成占位符，避免标签过多
+	idSegmentReg = regexp.MustCompile(`^([0-9]+|[0-9a-fA-F-]{32,}|.*[@~%].*)$`)
 )
 
+// SetLogger 设置诊断日志
+func SetLogger(l *zap.Logger) {
+	logger = l
+}
+
 type (
 	client struct {
 		port    int
@@ -69,10 +89,34 @@ func (cli client) req(method string, url string, data interface{}) ([]byte, erro
 	if req.Body != nil {
 		req.Header.Add("ContentType", "application/json")
 	}
+	start := time.Now()
+	endpoint := endpointLabel(url)
 	resp, err := httpCli.Do(req)
+	requestDuration.Since(start, method, endpoint)
 	if err != nil {
+		requestErrors.Inc(method, endpoint, "transport")
+		logger.Debug("客户端接口请求失败", zap.String("method", method), zap.String("url", url),
+			zap.Duration("cost", time.Since(start)), zap.Error(err))
 		return nil, err
 	}
 	defer resp.Body.Close()
-	return io.ReadAll(resp.Body)
+	if resp.StatusCode >= http.StatusBadRequest {
+		requestErrors.Inc(method, endpoint, strconv.Itoa(resp.StatusCode))
+	}
+	bts, err := io.ReadAll(resp.Body)
+	logger.Debug("客户端接口", zap.String("method", method), zap.String("url", url), zap.Int("status", resp.StatusCode),
+		zap.Duration("cost", time.Since(start)), zap.Int("size", len(bts)))
+	return bts, err
+}
+
+// endpointLabel 去掉查询参数并把id替换成{id}，例如 /lol-summoner/v1/summoners/{id}
+func endpointLabel(url string) string {
+	path, _, _ := strings.Cut(url, "?")
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if idSegmentReg.MatchString(segment) {
+			segments[i] = "{id}"
+		}
+	}
+	return strings.Join(segments, "/")
 }
@@ -44,17 +44,20 @@ const (
 	GameStatusHostARAM       GameStatus = "hosting_ARAM_UNRANKED_5x5" // 大乱斗5v5组队中-队长
 	GameStatusHostURF        GameStatus = "hosting_URF"               // 无限火力组队中-队长
 	GameStatusHostBOT        GameStatus = "hosting_BOT"               // 人机组队中-队长
+	GameStatusPostGame       GameStatus = "postGame"                  // 结算房间
 	GameFlowChampionSelect   GameFlow   = "ChampSelect"               // 英雄选择中
 	GameFlowReadyCheck       GameFlow   = "ReadyCheck"                // 等待接受对局
 	GameFlowInProgress       GameFlow   = "InProgress"                // 进行中
 	GameFlowMatchmaking      GameFlow   = "Matchmaking"               // 匹配中
 	GameFlowNone             GameFlow   = "None"                      // 无
+	GameFlowEndOfGame        GameFlow   = "EndOfGame"                 // 对局结算
 	// 排位等级
 	RankTierIron        RankTier = "IRON"        // 黑铁
 	RankTierBronze      RankTier = "BRONZE"      // 青铜
 	RankTierSilver      RankTier = "SILVER"      // 白银
 	RankTierGold        RankTier = "GOLD"        // 黄金
 	RankTierPlatinum    RankTier = "PLATINUM"    // 白金
+	RankTierEmerald     RankTier = "EMERALD"     // 翡翠
 	RankTierDiamond     RankTier = "DIAMOND"     // 钻石
 	RankTierMaster      RankTier = "MASTER"      // 大师
 	RankTierGrandMaster RankTier = "GRANDMASTER" // 宗师
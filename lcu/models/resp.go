@@ -1,6 +1,9 @@
 package models
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+)
 
 // PerMinDeltas 每单位的数据
 type PerMinDeltas struct {
@@ -435,6 +438,9 @@ type (
 				ProfileIcon       int    `json:"profileIcon"`
 				SummonerId        int64  `json:"summonerId"`
 				SummonerName      string `json:"summonerName"`
+				Puuid             string `json:"puuid"`
+				GameName          string `json:"gameName"`
+				TagLine           string `json:"tagLine"`
 			} `json:"player"`
 		} `json:"participantIdentities"`
 		Participants []Participant `json:"participants"`
@@ -503,19 +509,7 @@ type (
 		// IsSpectating         bool `json:"isSpectating"`
 		LocalPlayerCellId int `json:"localPlayerCellId"`
 		// LockedEventIndex     int  `json:"lockedEventIndex"`
-		// MyTeam               []struct {
-		// 	AssignedPosition    string `json:"assignedPosition"`
-		// 	CellId              int    `json:"cellId"`
-		// 	ChampionId          int    `json:"championId"`
-		// 	ChampionPickIntent  int    `json:"championPickIntent"`
-		// 	EntitledFeatureType string `json:"entitledFeatureType"`
-		// 	SelectedSkinId      int    `json:"selectedSkinId"`
-		// 	Spell1Id            int    `json:"spell1Id"`
-		// 	Spell2Id            int    `json:"spell2Id"`
-		// 	SummonerId          int64  `json:"summonerId"`
-		// 	Team                int    `json:"team"`
-		// 	WardSkinId          int    `json:"wardSkinId"`
-		// } `json:"myTeam"`
+		MyTeam []ChampSelectMember `json:"myTeam"`
 		// RecoveryCounter    int  `json:"recoveryCounter"`
 		// RerollsRemaining   int  `json:"rerollsRemaining"`
 		// SkipChampionSelect bool `json:"skipChampionSelect"`
@@ -541,6 +535,81 @@ type (
 		// } `json:"timer"`
 		// Trades []interface{} `json:"trades"`
 	}
+	// ChampSelectMember 英雄选择阶段的我方成员
+	ChampSelectMember struct {
+		AssignedPosition    string `json:"assignedPosition"` // top/jungle/middle/bottom/utility，匹配模式为空
+		CellId              int    `json:"cellId"`
+		ChampionId          int    `json:"championId"`
+		ChampionPickIntent  int    `json:"championPickIntent"`
+		EntitledFeatureType string `json:"entitledFeatureType"`
+		SelectedSkinId      int    `json:"selectedSkinId"`
+		Spell1Id            int    `json:"spell1Id"`
+		Spell2Id            int    `json:"spell2Id"`
+		SummonerId          int64  `json:"summonerId"`
+		Team                int    `json:"team"`
+		WardSkinId          int    `json:"wardSkinId"`
+	}
+	// ItemSets 召唤师的全部装备方案，ItemSets保持原样，修改时不会丢掉不认识的字段
+	ItemSets struct {
+		AccountId int64             `json:"accountId"`
+		ItemSets  []json.RawMessage `json:"itemSets"`
+		Timestamp int64             `json:"timestamp"`
+	}
+	// ItemSet 一个装备方案
+	ItemSet struct {
+		AssociatedChampions []int          `json:"associatedChampions"`
+		AssociatedMaps      []int          `json:"associatedMaps"`
+		Blocks              []ItemSetBlock `json:"blocks"`
+		Map                 string         `json:"map"`
+		Mode                string         `json:"mode"`
+		PreferredItemSlots  []any          `json:"preferredItemSlots"`
+		Sortrank            int            `json:"sortrank"`
+		StartedFrom         string         `json:"startedFrom"`
+		Title               string         `json:"title"`
+		Type                string         `json:"type"`
+		Uid                 string         `json:"uid"`
+	}
+	// ItemSetBlock 装备方案中的一栏
+	ItemSetBlock struct {
+		HideIfSummonerSpell string        `json:"hideIfSummonerSpell"`
+		Items               []ItemSetItem `json:"items"`
+		ShowIfSummonerSpell string        `json:"showIfSummonerSpell"`
+		Type                string        `json:"type"` // 栏目标题
+	}
+	// ItemSetItem 一件装备
+	ItemSetItem struct {
+		Count int    `json:"count"`
+		Id    string `json:"id"`
+	}
+	// PerkPage 符文页
+	PerkPage struct {
+		Id              int64  `json:"id,omitempty"`
+		Name            string `json:"name"`
+		Current         bool   `json:"current"`
+		IsActive        bool   `json:"isActive,omitempty"`
+		IsDeletable     bool   `json:"isDeletable,omitempty"`
+		IsEditable      bool   `json:"isEditable,omitempty"`
+		IsValid         bool   `json:"isValid,omitempty"`
+		PrimaryStyleId  int    `json:"primaryStyleId"`
+		SubStyleId      int    `json:"subStyleId"`
+		SelectedPerkIds []int  `json:"selectedPerkIds"`
+	}
+	// PerkInventory 符文页数量
+	PerkInventory struct {
+		CommonResp
+		OwnedPageCount int `json:"ownedPageCount"` // 可以自定义的符文页数
+	}
+	// Lobby 房间，英雄选择阶段仍然可以查询，只保留用到的字段
+	Lobby struct {
+		CommonResp
+		Members []LobbyMember `json:"members"` // 开黑的队友，单排时只有自己
+	}
+	// LobbyMember 房间成员
+	LobbyMember struct {
+		IsLeader   bool   `json:"isLeader"`
+		Puuid      string `json:"puuid"`
+		SummonerId int64  `json:"summonerId"`
+	}
 	GameFolwSessionTeamUser struct {
 		AccountId         float64 `json:"accountId,omitempty"`
 		AdjustmentFlags   float64 `json:"adjustmentFlags,omitempty"`
@@ -595,7 +664,7 @@ type (
 		// 	Visible            bool   `json:"visible"`
 		// } `json:"gameClient"`
 		GameData struct {
-			// GameId                   int64  `json:"gameId"`
+			GameId int64 `json:"gameId"`
 			// GameName                 string `json:"gameName"`
 			// IsCustomGame             bool   `json:"isCustomGame"`
 			// Password                 string `json:"password"`
@@ -665,6 +734,9 @@ type (
 			// 	SpectatorEnabled            bool   `json:"spectatorEnabled"`
 			// 	Type                        string `json:"type"`
 			// } `json:"queue"`
+			Queue struct {
+				Id int `json:"id"` // 队列id
+			} `json:"queue"`
 			SpectatorsAllowed bool                      `json:"spectatorsAllowed"`
 			TeamOne           []GameFolwSessionTeamUser `json:"teamOne"`
 			TeamTwo           []GameFolwSessionTeamUser `json:"teamTwo"`
@@ -707,27 +779,45 @@ type (
 			} `json:"assets"`
 			CategorizedContentBundles struct {
 			} `json:"categorizedContentBundles"`
-			Description                         string `json:"description"`
-			GameMode                            string `json:"gameMode"`
-			GameModeName                        string `json:"gameModeName"`
-			GameModeShortName                   string `json:"gameModeShortName"`
-			GameMutator                         string `json:"gameMutator"`
-			Id                                  int    `json:"id"`
-			IsRGM                               bool   `json:"isRGM"`
-			MapStringId                         string `json:"mapStringId"`
-			Name                                string `json:"name"`
-			PerPositionDisallowedSummonerSpells struct {
-			} `json:"perPositionDisallowedSummonerSpells"`
-			PerPositionRequiredSummonerSpells struct {
-			} `json:"perPositionRequiredSummonerSpells"`
-			PlatformId   string `json:"platformId"`
-			PlatformName string `json:"platformName"`
-			Properties   struct {
+			Description                         string         `json:"description"`
+			GameMode                            string         `json:"gameMode"`
+			GameModeName                        string         `json:"gameModeName"`
+			GameModeShortName                   string         `json:"gameModeShortName"`
+			GameMutator                         string         `json:"gameMutator"`
+			Id                                  int            `json:"id"`
+			IsRGM                               bool           `json:"isRGM"`
+			MapStringId                         string         `json:"mapStringId"`
+			Name                                string         `json:"name"`
+			PerPositionDisallowedSummonerSpells PositionSpells `json:"perPositionDisallowedSummonerSpells"` // 各位置不能带的召唤师技能
+			PerPositionRequiredSummonerSpells   PositionSpells `json:"perPositionRequiredSummonerSpells"`   // 各位置必须带的召唤师技能
+			PlatformId                          string         `json:"platformId"`
+			PlatformName                        string         `json:"platformName"`
+			Properties                          struct {
 				SuppressRunesMasteriesPerks bool `json:"suppressRunesMasteriesPerks"`
 			} `json:"properties"`
 		} `json:"map"`
 		Phase GameFlow `json:"phase"`
 	}
+	// RankedStats 排位信息
+	RankedStats struct {
+		CommonResp
+		QueueMap map[GameQueueType]RankedQueueStats `json:"queueMap"`
+	}
+	// RankedQueueStats 单个队列的排位信息
+	RankedQueueStats struct {
+		QueueType                     GameQueueType `json:"queueType"`
+		Tier                          RankTier      `json:"tier"`         // 段位
+		Division                      string        `json:"division"`     // 小段 I~IV，大师以上为NA
+		LeaguePoints                  int           `json:"leaguePoints"` // 胜点
+		Wins                          int           `json:"wins"`
+		Losses                        int           `json:"losses"`
+		IsProvisional                 bool          `json:"isProvisional"` // 是否定级中
+		ProvisionalGamesRemaining     int           `json:"provisionalGamesRemaining"`
+		HighestTier                   RankTier      `json:"highestTier"`                   // 本赛季最高段位
+		HighestDivision               string        `json:"highestDivision"`               // 本赛季最高小段
+		PreviousSeasonHighestTier     RankTier      `json:"previousSeasonHighestTier"`     // 上赛季最高段位
+		PreviousSeasonHighestDivision string        `json:"previousSeasonHighestDivision"` // 上赛季最高小段
+	}
 	UpdateSummonerProfileData struct {
 		Availability Availability `json:"availability"`
 	}
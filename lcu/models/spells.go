@@ -0,0 +1,30 @@
+package models
+
+import "encoding/json"
+
+// PositionSpells 位置 -> 召唤师技能id，位置为大写的 TOP/JUNGLE/MIDDLE/BOTTOM/UTILITY
+type PositionSpells map[string][]Spell
+
+// UnmarshalJSON 兼容 {"JUNGLE":{"spells":[11]}} 和 {"JUNGLE":[11]}，无法解析时忽略，不影响整个会话
+func (p *PositionSpells) UnmarshalJSON(bts []byte) error {
+	var raw map[string]json.RawMessage
+	if json.Unmarshal(bts, &raw) != nil {
+		*p = nil
+		return nil
+	}
+	res := make(PositionSpells, len(raw))
+	for position, v := range raw {
+		var list []Spell
+		wrapped := struct {
+			Spells []Spell `json:"spells"`
+		}{}
+		switch {
+		case json.Unmarshal(v, &list) == nil:
+			res[position] = list
+		case json.Unmarshal(v, &wrapped) == nil:
+			res[position] = wrapped.Spells
+		}
+	}
+	*p = res
+	return nil
+}
@@ -0,0 +1,192 @@
+package LOLTalentScout
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+	"main.go/config"
+	"main.go/lcu"
+	"main.go/lcu/models"
+	"main.go/liveclient"
+	"main.go/logger"
+	"main.go/store"
+	"main.go/timers"
+)
+
+const liveStoreKind = "live" // 游戏内时间线存储目录
+
+// dragonNames 小龙类型
+var dragonNames = map[string]string{
+	"Fire":     "火龙",
+	"Water":    "海洋龙",
+	"Earth":    "山脉龙",
+	"Air":      "云端龙",
+	"Hextech":  "海克斯龙",
+	"Chemtech": "炼金龙",
+	"Elder":    "远古龙",
+}
+
+// trackLiveGame 游戏进行中轮询本地游戏接口，更新记分板并推送事件，离开游戏后保存时间线
+func (ts *TalentScout) trackLiveGame() {
+	conf := config.Get().LiveClient
+	if !conf.Enabled {
+		return
+	}
+	session, err := lcu.QueryGameFlowSession()
+	if err != nil || session.GameData.GameId == 0 {
+		ts.log.Warn("查询对局id失败,不记录游戏内数据", zap.Error(err))
+		return
+	}
+	gameID := session.GameData.GameId
+	ctx, cancel := context.WithCancel(ts.ctx)
+	defer cancel()
+	ts.mu.Lock()
+	//重连客户端后会再次收到游戏中状态，已经在记录就不再开始
+	if ts.liveCancel != nil {
+		ts.mu.Unlock()
+		return
+	}
+	ts.liveCancel = cancel
+	ts.mu.Unlock()
+	defer func() {
+		ts.mu.Lock()
+		ts.liveCancel = nil
+		ts.mu.Unlock()
+	}()
+
+	log := logger.Lobby(ts.log, gameID, string(models.GameFlowInProgress))
+	client := liveclient.NewClient(conf.URL, time.Duration(conf.TimeoutMs)*time.Millisecond)
+	tracker := liveclient.NewTracker(gameID, time.Duration(conf.SampleEverySec)*time.Second)
+	timerTracker := ts.startTimers(ctx)
+	ticker := time.NewTicker(time.Duration(conf.PollIntervalMs) * time.Millisecond)
+	defer ticker.Stop()
+	polled := false
+	for ended := false; !ended; {
+		select {
+		case <-ctx.Done():
+			ended = true
+			continue
+		case <-ticker.C:
+		}
+		snapshot, events, err := ts.pollLiveGame(ctx, client, tracker, timerTracker)
+		if err != nil {
+			if !errors.Is(err, liveclient.ErrNotReady) && ctx.Err() == nil {
+				log.Debug("读取游戏内数据失败", zap.Error(err))
+			}
+			continue
+		}
+		polled = true
+		ts.mu.Lock()
+		ts.live = &snapshot
+		ts.mu.Unlock()
+		ts.sink.PublishLive(snapshot)
+		for _, e := range events {
+			ts.sink.PublishGameEvent(e)
+			if msg := describeLiveEvent(e, tracker, snapshot.SelfTeam); msg != "" {
+				fmt.Println(msg)
+			}
+			ended = ended || e.EventName == liveclient.EventGameEnd
+		}
+	}
+	if !polled {
+		return
+	}
+	timeline := tracker.Timeline()
+	if err = store.Save(liveStoreKind, strconv.FormatInt(gameID, 10), timeline); err != nil {
+		log.Error("保存游戏内时间线失败", zap.Error(err))
+		return
+	}
+	log.Info("已保存游戏内时间线", zap.Int("points", len(timeline.Points)), zap.Int("events", len(timeline.Events)))
+}
+
+// pollLiveGame 拉取一次记分板和新事件，timerTracker不为空时更新计时
+func (ts *TalentScout) pollLiveGame(ctx context.Context, client *liveclient.Client, tracker *liveclient.Tracker,
+	timerTracker *timers.Tracker) (liveclient.Snapshot, []liveclient.Event, error) {
+	data, err := client.AllGameData(ctx)
+	if err != nil {
+		return liveclient.Snapshot{}, nil, err
+	}
+	events, err := client.EventData(ctx, tracker.NextEventID())
+	if err != nil {
+		return liveclient.Snapshot{}, nil, err
+	}
+	snapshot, newEvents := tracker.Update(data, events)
+	if timerTracker != nil {
+		timerTracker.Update(data, snapshot, newEvents)
+	}
+	return snapshot, newEvents, nil
+}
+
+// stopLiveGame 离开游戏后停止轮询
+func (ts *TalentScout) stopLiveGame() {
+	ts.mu.Lock()
+	if ts.liveCancel != nil {
+		ts.liveCancel()
+	}
+	ts.mu.Unlock()
+}
+
+// LiveGame 当前游戏的记分板，不在游戏中时返回false
+func (ts *TalentScout) LiveGame() (liveclient.Snapshot, bool) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	if ts.live == nil || ts.liveCancel == nil {
+		return liveclient.Snapshot{}, false
+	}
+	return *ts.live, true
+}
+
+// describeLiveEvent 命令行显示的事件，只显示自己的击杀、大龙小龙、推水晶和团灭，其他事件返回空
+func describeLiveEvent(e liveclient.Event, tracker *liveclient.Tracker, selfTeam liveclient.Team) string {
+	side := func(team liveclient.Team) string {
+		switch {
+		case team == "":
+			return ""
+		case team == selfTeam:
+			return "我方"
+		default:
+			return "敌方"
+		}
+	}
+	self := tracker.Snapshot().Self
+	killerSide := side(tracker.EventTeam(e))
+	msg := ""
+	switch e.EventName {
+	case liveclient.EventChampionKill:
+		if liveclient.SamePlayer(e.KillerName, self) {
+			msg = "你击杀了" + e.VictimName
+		} else if liveclient.SamePlayer(e.VictimName, self) {
+			msg = "你被" + e.KillerName + "击杀"
+		}
+	case liveclient.EventDragonKill:
+		dragon, ok := dragonNames[e.DragonType]
+		if !ok {
+			dragon = e.DragonType + "龙"
+		}
+		msg = killerSide + "拿下了" + dragon
+		if e.Stolen == "True" {
+			msg += "(抢龙)"
+		}
+	case liveclient.EventHeraldKill:
+		msg = killerSide + "拿下了峡谷先锋"
+	case liveclient.EventBaronKill:
+		msg = killerSide + "拿下了纳什男爵"
+		if e.Stolen == "True" {
+			msg += "(抢龙)"
+		}
+	case liveclient.EventInhibKilled:
+		msg = killerSide + "推掉了水晶"
+	case liveclient.EventAce:
+		msg = killerSide + "团灭了对面"
+	case liveclient.EventGameEnd:
+		msg = "游戏结束:" + e.Result
+	}
+	if msg == "" {
+		return ""
+	}
+	return fmt.Sprintf("[%s] %s", liveclient.FormatGameTime(e.EventTime), msg)
+}
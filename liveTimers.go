@@ -0,0 +1,134 @@
+package LOLTalentScout
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"go.uber.org/zap"
+	"main.go/config"
+	"main.go/desktop"
+	"main.go/liveclient"
+	"main.go/timers"
+)
+
+// ErrNotInGame 不在游戏中或没有开启游戏内计时
+var ErrNotInGame = errors.New("当前不在游戏中或没有开启游戏内计时")
+
+// TimersStatus 游戏内计时
+type TimersStatus struct {
+	Timers   []timers.Timer `json:"timers"`
+	Enemies  []timers.Enemy `json:"enemies"`  // 标记技能时的序号从1开始
+	ChatLine string         `json:"chatLine"` // 可以直接粘贴到游戏聊天的一行
+}
+
+// MarkSpellReq 标记敌方交了召唤师技能
+type MarkSpellReq struct {
+	Target string `json:"target"` // 敌方序号(1开始)、英雄名或玩家名
+	Spell  string `json:"spell"`  // 为空时优先闪现，other为另一个技能，也可以是1/2、flash、tp
+}
+
+// timerHotkeys 前5个标记敌方1~5号的闪现，后5个加Shift标记另一个技能，最后一个复制计时
+var timerHotkeys = func() []desktop.Hotkey {
+	keys := make([]desktop.Hotkey, 0, 11)
+	for _, mods := range []desktop.Modifier{desktop.ModCtrl | desktop.ModAlt,
+		desktop.ModCtrl | desktop.ModAlt | desktop.ModShift} {
+		for i := 1; i <= 5; i++ {
+			keys = append(keys, desktop.Hotkey{Mods: mods, Key: rune('0' + i)})
+		}
+	}
+	return append(keys, desktop.Hotkey{Mods: desktop.ModCtrl | desktop.ModAlt, Key: '0'})
+}()
+
+// startTimers 开始一局游戏的计时，未开启时返回nil
+func (ts *TalentScout) startTimers(ctx context.Context) *timers.Tracker {
+	conf := config.Get()
+	if !conf.Timers.Enabled {
+		return nil
+	}
+	tracker := timers.New(conf.Timers.AssumeCosmicInsight)
+	ts.mu.Lock()
+	ts.timers = tracker
+	ts.mu.Unlock()
+	context.AfterFunc(ctx, func() {
+		ts.mu.Lock()
+		ts.timers = nil
+		ts.mu.Unlock()
+	})
+	if conf.Timers.Hotkeys {
+		go ts.listenTimerHotkeys(ctx)
+	}
+	return tracker
+}
+
+// listenTimerHotkeys 游戏中监听计时快捷键
+func (ts *TalentScout) listenTimerHotkeys(ctx context.Context) {
+	err := desktop.ListenHotkeys(ctx, timerHotkeys, func(i int) {
+		var err error
+		switch {
+		case i < 5:
+			_, err = ts.MarkEnemySpell(strconv.Itoa(i+1), "")
+		case i < 10:
+			_, err = ts.MarkEnemySpell(strconv.Itoa(i-4), "other")
+		default:
+			_, err = ts.CopyTimers()
+		}
+		if err != nil {
+			fmt.Println(err)
+		}
+	})
+	if err != nil && !errors.Is(err, desktop.ErrUnsupported) {
+		ts.log.Warn("注册计时快捷键失败", zap.Error(err))
+	}
+}
+
+// MarkEnemySpell 标记敌方交了召唤师技能，开启了剪贴板时把计时复制到剪贴板
+func (ts *TalentScout) MarkEnemySpell(target, spell string) (timers.Timer, error) {
+	ts.mu.Lock()
+	tracker := ts.timers
+	ts.mu.Unlock()
+	if tracker == nil {
+		return timers.Timer{}, ErrNotInGame
+	}
+	timer, err := tracker.MarkSpell(target, spell)
+	if err != nil {
+		return timer, err
+	}
+	fmt.Printf("已标记%s,%s后好(%s)\n", timer.Label, liveclient.FormatGameTime(timer.Remaining),
+		liveclient.FormatGameTime(timer.ReadyAt))
+	if config.Get().Timers.Clipboard {
+		if err = desktop.SetClipboard(tracker.ChatLine()); err != nil && !errors.Is(err, desktop.ErrUnsupported) {
+			ts.log.Warn("复制计时失败", zap.Error(err))
+		}
+	}
+	return timer, nil
+}
+
+// CopyTimers 把计时复制到剪贴板，返回复制的内容
+func (ts *TalentScout) CopyTimers() (string, error) {
+	status, err := ts.Timers()
+	if err != nil {
+		return "", err
+	}
+	if err = desktop.SetClipboard(status.ChatLine); err != nil {
+		return status.ChatLine, err
+	}
+	fmt.Println("已复制计时:", status.ChatLine)
+	return status.ChatLine, nil
+}
+
+// Timers 当前的游戏内计时
+func (ts *TalentScout) Timers() (TimersStatus, error) {
+	ts.mu.Lock()
+	tracker := ts.timers
+	ts.mu.Unlock()
+	if tracker == nil {
+		return TimersStatus{}, ErrNotInGame
+	}
+	return TimersStatus{
+		Timers:   tracker.Timers(),
+		Enemies:  tracker.Enemies(),
+		ChatLine: tracker.ChatLine(),
+	}, nil
+}
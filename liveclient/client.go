@@ -0,0 +1,86 @@
+package liveclient
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// DefaultURL 本地游戏接口地址，只在游戏进行中可以访问
+const DefaultURL = "https://127.0.0.1:2999"
+
+// ErrNotReady 游戏还在加载或已经结束，接口暂时没有数据
+var ErrNotReady = errors.New("游戏数据暂不可用")
+
+// Client 本地游戏接口(Live Client Data API)客户端，证书是游戏自签的，不做校验
+type Client struct {
+	baseURL string
+	httpCli *http.Client
+}
+
+// NewClient 创建客户端，timeout为每次请求的超时时间
+func NewClient(baseURL string, timeout time.Duration) *Client {
+	return &Client{
+		baseURL: baseURL,
+		httpCli: &http.Client{
+			Timeout: timeout,
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{
+					InsecureSkipVerify: true,
+				},
+			},
+		},
+	}
+}
+
+// AllGameData 记分板、装备、对局信息和全部事件
+func (c *Client) AllGameData(ctx context.Context) (*AllGameData, error) {
+	data := &AllGameData{}
+	if err := c.get(ctx, "/liveclientdata/allgamedata", data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// EventData 事件id不小于fromID的事件，fromID为0时返回全部事件
+func (c *Client) EventData(ctx context.Context, fromID int) ([]Event, error) {
+	data := EventData{}
+	path := "/liveclientdata/eventdata"
+	if fromID > 0 {
+		path += "?eventID=" + strconv.Itoa(fromID)
+	}
+	if err := c.get(ctx, path, &data); err != nil {
+		return nil, err
+	}
+	return data.Events, nil
+}
+
+func (c *Client) get(ctx context.Context, path string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+path, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := c.httpCli.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	// 加载界面时返回404
+	if resp.StatusCode == http.StatusNotFound {
+		return ErrNotReady
+	}
+	bts, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("游戏接口返回%d: %s", resp.StatusCode, bts)
+	}
+	return json.Unmarshal(bts, v)
+}
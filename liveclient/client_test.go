@@ -0,0 +1,65 @@
+package liveclient
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestClientNotReady(t *testing.T) {
+	f := NewFakeServer()
+	defer f.Close()
+	c := NewClient(f.URL(), time.Second)
+	if _, err := c.AllGameData(context.Background()); !errors.Is(err, ErrNotReady) {
+		t.Errorf("加载界面时AllGameData应返回ErrNotReady,实际%v", err)
+	}
+	if _, err := c.EventData(context.Background(), 0); !errors.Is(err, ErrNotReady) {
+		t.Errorf("加载界面时EventData应返回ErrNotReady,实际%v", err)
+	}
+}
+
+func TestClientEventData(t *testing.T) {
+	f := NewFakeServer()
+	defer f.Close()
+	f.SetGameData(AllGameData{GameData: GameData{GameMode: "CLASSIC", GameTime: 30}})
+	f.AddEvents(Event{EventName: EventGameStart}, Event{EventName: EventChampionKill},
+		Event{EventName: EventDragonKill})
+	c := NewClient(f.URL(), time.Second)
+
+	data, err := c.AllGameData(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data.GameData.GameMode != "CLASSIC" || len(data.Events.Events) != 3 {
+		t.Errorf("AllGameData = %+v", data)
+	}
+	tests := []struct {
+		fromID int
+		want   []string
+	}{
+		{0, []string{EventGameStart, EventChampionKill, EventDragonKill}},
+		{1, []string{EventChampionKill, EventDragonKill}},
+		{3, nil},
+	}
+	for _, tt := range tests {
+		events, err := c.EventData(context.Background(), tt.fromID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := make([]string, 0, len(events))
+		for _, e := range events {
+			got = append(got, e.EventName)
+		}
+		if len(got) != len(tt.want) {
+			t.Errorf("fromID=%d: %v, 应为%v", tt.fromID, got, tt.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("fromID=%d: %v, 应为%v", tt.fromID, got, tt.want)
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package liveclient
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+)
+
+// FakeServer 模拟本地游戏接口，没有设置数据前和加载界面一样返回404
+type FakeServer struct {
+	srv    *httptest.Server
+	mu     sync.Mutex
+	data   *AllGameData
+	events []Event
+}
+
+// NewFakeServer 启动模拟接口，用完需要Close
+func NewFakeServer() *FakeServer {
+	f := &FakeServer{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /liveclientdata/allgamedata", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		if f.data == nil {
+			http.NotFound(w, r)
+			return
+		}
+		data := *f.data
+		data.Events = EventData{Events: f.events}
+		writeJSON(w, data)
+	})
+	mux.HandleFunc("GET /liveclientdata/eventdata", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		if f.data == nil {
+			http.NotFound(w, r)
+			return
+		}
+		fromID, _ := strconv.Atoi(r.URL.Query().Get("eventID"))
+		events := make([]Event, 0, len(f.events))
+		for _, e := range f.events {
+			if e.EventID >= fromID {
+				events = append(events, e)
+			}
+		}
+		writeJSON(w, EventData{Events: events})
+	})
+	f.srv = httptest.NewTLSServer(mux)
+	return f
+}
+
+// URL 模拟接口地址，传给NewClient
+func (f *FakeServer) URL() string {
+	return f.srv.URL
+}
+
+// SetGameData 设置记分板和对局信息，事件用AddEvents添加
+func (f *FakeServer) SetGameData(data AllGameData) {
+	f.mu.Lock()
+	f.data = &data
+	f.mu.Unlock()
+}
+
+// AddEvents 按顺序追加事件，自动分配事件id
+func (f *FakeServer) AddEvents(events ...Event) {
+	f.mu.Lock()
+	for _, e := range events {
+		e.EventID = len(f.events)
+		f.events = append(f.events, e)
+	}
+	f.mu.Unlock()
+}
+
+// Close 关闭模拟接口
+func (f *FakeServer) Close() {
+	f.srv.Close()
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,154 @@
+package liveclient
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Team 游戏内的阵营，ORDER是蓝色方，CHAOS是红色方
+type Team string
+
+const (
+	TeamOrder Team = "ORDER"
+	TeamChaos Team = "CHAOS"
+)
+
+// 事件名称，只列出用到的
+const (
+	EventGameStart    = "GameStart"
+	EventChampionKill = "ChampionKill"
+	EventDragonKill   = "DragonKill"
+	EventHeraldKill   = "HeraldKill"
+	EventBaronKill    = "BaronKill"
+	EventTurretKilled = "TurretKilled"
+	EventInhibKilled  = "InhibKilled"
+	EventAce          = "Ace"
+	EventGameEnd      = "GameEnd"
+)
+
+type (
+	// AllGameData /liveclientdata/allgamedata 的返回，只保留用到的字段
+	AllGameData struct {
+		ActivePlayer ActivePlayer `json:"activePlayer"`
+		AllPlayers   []Player     `json:"allPlayers"`
+		Events       EventData    `json:"events"`
+		GameData     GameData     `json:"gameData"`
+	}
+	// ActivePlayer 自己，只有自己的金币是准确的
+	ActivePlayer struct {
+		RiotID       string  `json:"riotId"`
+		SummonerName string  `json:"summonerName"`
+		CurrentGold  float64 `json:"currentGold"`
+		Level        int     `json:"level"`
+	}
+	// Player 记分板上的玩家
+	Player struct {
+		ChampionName   string       `json:"championName"`
+		RiotID         string       `json:"riotId"`
+		RiotIDGameName string       `json:"riotIdGameName"`
+		SummonerName   string       `json:"summonerName"`
+		Team           Team         `json:"team"`
+		Position       string       `json:"position"`
+		Level          int          `json:"level"`
+		IsDead         bool         `json:"isDead"`
+		RespawnTimer   float64      `json:"respawnTimer"`
+		Items          []Item       `json:"items"`
+		Scores         PlayerScores `json:"scores"`
+		SummonerSpells struct {
+			One SummonerSpell `json:"summonerSpellOne"`
+			Two SummonerSpell `json:"summonerSpellTwo"`
+		} `json:"summonerSpells"`
+		Runes struct {
+			Keystone          Rune `json:"keystone"`
+			PrimaryRuneTree   Rune `json:"primaryRuneTree"`
+			SecondaryRuneTree Rune `json:"secondaryRuneTree"`
+		} `json:"runes"` // 其他玩家只有基石和符文系
+	}
+	// SummonerSpell 召唤师技能
+	SummonerSpell struct {
+		DisplayName    string `json:"displayName"`
+		RawDisplayName string `json:"rawDisplayName"` // 例如 GeneratedTip_SummonerSpell_SummonerFlash_DisplayName
+	}
+	// Rune 符文或符文系
+	Rune struct {
+		ID          int    `json:"id"`
+		DisplayName string `json:"displayName"`
+	}
+	// Item 装备
+	Item struct {
+		ItemID      int    `json:"itemID"`
+		DisplayName string `json:"displayName"`
+		Count       int    `json:"count"`
+		Price       int    `json:"price"`
+		Slot        int    `json:"slot"`
+	}
+	// PlayerScores 记分板数据
+	PlayerScores struct {
+		Kills      int     `json:"kills"`
+		Deaths     int     `json:"deaths"`
+		Assists    int     `json:"assists"`
+		CreepScore int     `json:"creepScore"`
+		WardScore  float64 `json:"wardScore"`
+	}
+	// GameData 对局信息，gameTime为游戏内秒数
+	GameData struct {
+		GameMode string  `json:"gameMode"`
+		GameTime float64 `json:"gameTime"`
+		MapName  string  `json:"mapName"`
+	}
+	// EventData /liveclientdata/eventdata 的返回
+	EventData struct {
+		Events []Event `json:"Events"`
+	}
+	// Event 游戏内事件，不同事件用到的字段不同
+	Event struct {
+		EventID      int      `json:"EventID"`
+		EventName    string   `json:"EventName"`
+		EventTime    float64  `json:"EventTime"`
+		KillerName   string   `json:"KillerName,omitempty"`
+		VictimName   string   `json:"VictimName,omitempty"`
+		Assisters    []string `json:"Assisters,omitempty"`
+		DragonType   string   `json:"DragonType,omitempty"`
+		Stolen       string   `json:"Stolen,omitempty"` // "True"或"False"
+		TurretKilled string   `json:"TurretKilled,omitempty"`
+		InhibKilled  string   `json:"InhibKilled,omitempty"`
+		Acer         string   `json:"Acer,omitempty"`
+		AcingTeam    Team     `json:"AcingTeam,omitempty"`
+		Result       string   `json:"Result,omitempty"` // GameEnd: Win或Lose
+	}
+)
+
+// Name 玩家名字，新版本为Riot ID
+func (p Player) Name() string {
+	if p.RiotID != "" {
+		return p.RiotID
+	}
+	return p.SummonerName
+}
+
+// Name 自己的名字，新版本为Riot ID
+func (p ActivePlayer) Name() string {
+	if p.RiotID != "" {
+		return p.RiotID
+	}
+	return p.SummonerName
+}
+
+// Key 技能的英文key，例如SummonerFlash，不受客户端语言影响
+func (s SummonerSpell) Key() string {
+	key := strings.TrimPrefix(s.RawDisplayName, "GeneratedTip_SummonerSpell_")
+	return strings.TrimSuffix(key, "_DisplayName")
+}
+
+// FormatGameTime 游戏内秒数转成 分:秒
+func FormatGameTime(sec float64) string {
+	return fmt.Sprintf("%02d:%02d", int(sec)/60, int(sec)%60)
+}
+
+// Opponent 对面阵营
+func (t Team) Opponent() Team {
+	if t == TeamOrder {
+		return TeamChaos
+	}
+	return TeamOrder
+}
@@ -0,0 +1,258 @@
+package liveclient
+
+import (
+	"strings"
+	"time"
+)
+
+type (
+	// Snapshot 某一时刻的记分板
+	Snapshot struct {
+		GameID     int64              `json:"gameId"`
+		GameMode   string             `json:"gameMode"`
+		GameTime   float64            `json:"gameTime"`
+		Self       string             `json:"self"`       // 自己的名字
+		SelfTeam   Team               `json:"selfTeam"`   // 自己的阵营
+		SelfGold   float64            `json:"selfGold"`   // 自己当前的金币
+		Players    []PlayerState      `json:"players"`    // 按接口顺序，前五个一般是蓝色方
+		Teams      map[Team]TeamState `json:"teams"`      // 双方汇总
+		LastEvents []Event            `json:"lastEvents"` // 最近几个事件
+		UpdatedAt  time.Time          `json:"updatedAt"`
+	}
+	// PlayerState 一名玩家的记分板数据
+	PlayerState struct {
+		Name       string `json:"name"`
+		Champion   string `json:"champion"`
+		Team       Team   `json:"team"`
+		Level      int    `json:"level"`
+		Kills      int    `json:"kills"`
+		Deaths     int    `json:"deaths"`
+		Assists    int    `json:"assists"`
+		CreepScore int    `json:"creepScore"`
+		IsDead     bool   `json:"isDead"`
+		Items      []int  `json:"items"`    // 装备id
+		ItemGold   int    `json:"itemGold"` // 装备价格之和，用来估算经济
+	}
+	// TeamState 一方的汇总，经济只能按装备价格估算
+	TeamState struct {
+		Kills    int      `json:"kills"`
+		ItemGold int      `json:"itemGold"`
+		Dragons  []string `json:"dragons"` // 按击杀顺序的小龙类型
+		Heralds  int      `json:"heralds"`
+		Barons   int      `json:"barons"`
+		Turrets  int      `json:"turrets"` // 推掉的防御塔
+		Inhibs   int      `json:"inhibs"`  // 推掉的水晶
+	}
+	// TimelinePoint 时间线上的一个采样点
+	TimelinePoint struct {
+		GameTime float64            `json:"gameTime"`
+		Teams    map[Team]TeamState `json:"teams"`
+		Players  []PlayerState      `json:"players"`
+	}
+	// Timeline 一局游戏的时间线，游戏结束后按对局id保存
+	Timeline struct {
+		GameID    int64           `json:"gameId"`
+		GameMode  string          `json:"gameMode"`
+		Self      string          `json:"self"`
+		SelfTeam  Team            `json:"selfTeam"`
+		Result    string          `json:"result"` // Win或Lose，中途退出时为空
+		Points    []TimelinePoint `json:"points"`
+		Events    []Event         `json:"events"`
+		StartedAt time.Time       `json:"startedAt"`
+	}
+)
+
+// lastEventsSize 快照里保留的最近事件数
+const lastEventsSize = 10
+
+// Tracker 汇总每次轮询的结果，去掉重复事件，按间隔在时间线上采样
+type Tracker struct {
+	timeline    Timeline
+	snapshot    Snapshot
+	teams       map[string]Team // 玩家名字 -> 阵营，事件里的名字可能带或不带#tag
+	nextEventID int
+	sampleEvery float64
+	lastSample  float64
+}
+
+// NewTracker 创建一局游戏的记录，sampleEvery为时间线的采样间隔(游戏内时间)
+func NewTracker(gameID int64, sampleEvery time.Duration) *Tracker {
+	return &Tracker{
+		timeline:    Timeline{GameID: gameID, StartedAt: time.Now()},
+		teams:       map[string]Team{},
+		sampleEvery: sampleEvery.Seconds(),
+		lastSample:  -1,
+	}
+}
+
+// NextEventID 下次拉取事件的起始id
+func (t *Tracker) NextEventID() int {
+	return t.nextEventID
+}
+
+// Update 用最新的记分板和事件更新记录，返回最新快照和新出现的事件
+func (t *Tracker) Update(data *AllGameData, events []Event) (Snapshot, []Event) {
+	for _, p := range data.AllPlayers {
+		for _, name := range []string{p.RiotID, p.RiotIDGameName, p.SummonerName} {
+			if name != "" {
+				t.teams[name] = p.Team
+			}
+		}
+	}
+	newEvents := make([]Event, 0, len(events))
+	for _, e := range events {
+		if e.EventID < t.nextEventID {
+			continue
+		}
+		t.nextEventID = e.EventID + 1
+		newEvents = append(newEvents, e)
+		t.timeline.Events = append(t.timeline.Events, e)
+		if e.EventName == EventGameEnd {
+			t.timeline.Result = e.Result
+		}
+	}
+
+	snapshot := Snapshot{
+		GameID:    t.timeline.GameID,
+		GameMode:  data.GameData.GameMode,
+		GameTime:  data.GameData.GameTime,
+		Self:      data.ActivePlayer.Name(),
+		SelfTeam:  t.TeamOf(data.ActivePlayer.Name()),
+		SelfGold:  data.ActivePlayer.CurrentGold,
+		Players:   make([]PlayerState, 0, len(data.AllPlayers)),
+		Teams:     t.objectives(),
+		UpdatedAt: time.Now(),
+	}
+	for _, p := range data.AllPlayers {
+		player := PlayerState{
+			Name:       p.Name(),
+			Champion:   p.ChampionName,
+			Team:       p.Team,
+			Level:      p.Level,
+			Kills:      p.Scores.Kills,
+			Deaths:     p.Scores.Deaths,
+			Assists:    p.Scores.Assists,
+			CreepScore: p.Scores.CreepScore,
+			IsDead:     p.IsDead,
+			Items:      make([]int, 0, len(p.Items)),
+		}
+		for _, item := range p.Items {
+			player.Items = append(player.Items, item.ItemID)
+			player.ItemGold += item.Price * max(item.Count, 1)
+		}
+		snapshot.Players = append(snapshot.Players, player)
+		team := snapshot.Teams[p.Team]
+		team.Kills += player.Kills
+		team.ItemGold += player.ItemGold
+		snapshot.Teams[p.Team] = team
+	}
+	events = t.timeline.Events
+	snapshot.LastEvents = events[max(len(events)-lastEventsSize, 0):]
+	t.snapshot = snapshot
+
+	t.timeline.GameMode = snapshot.GameMode
+	t.timeline.Self, t.timeline.SelfTeam = snapshot.Self, snapshot.SelfTeam
+	if t.lastSample < 0 || snapshot.GameTime-t.lastSample >= t.sampleEvery {
+		t.sample()
+	}
+	return snapshot, newEvents
+}
+
+// Snapshot 最新快照
+func (t *Tracker) Snapshot() Snapshot {
+	return t.snapshot
+}
+
+// Timeline 到目前为止的时间线，最后一个采样点是最新快照
+func (t *Tracker) Timeline() Timeline {
+	if t.lastSample >= 0 && t.snapshot.GameTime > t.lastSample {
+		t.sample()
+	}
+	return t.timeline
+}
+
+// EventTeam 事件记在哪一方，推塔和水晶被小兵推掉时按建筑名字判断
+func (t *Tracker) EventTeam(e Event) Team {
+	team := t.TeamOf(e.KillerName)
+	switch e.EventName {
+	case EventTurretKilled:
+		team = structureDestroyer(e.TurretKilled, team)
+	case EventInhibKilled:
+		team = structureDestroyer(e.InhibKilled, team)
+	case EventAce:
+		team = e.AcingTeam
+	}
+	return team
+}
+
+// SamePlayer 事件里的名字是否是该玩家，事件里的名字可能不带#tag
+func SamePlayer(eventName, name string) bool {
+	if eventName == "" {
+		return false
+	}
+	if i := strings.IndexByte(name, '#'); i >= 0 && !strings.Contains(eventName, "#") {
+		name = name[:i]
+	}
+	return eventName == name
+}
+
+// TeamOf 玩家所在阵营，不认识的名字(小兵、防御塔等)返回空
+func (t *Tracker) TeamOf(name string) Team {
+	if team, ok := t.teams[name]; ok {
+		return team
+	}
+	// 事件里的名字可能不带#tag
+	if i := strings.IndexByte(name, '#'); i >= 0 {
+		return t.teams[name[:i]]
+	}
+	return ""
+}
+
+func (t *Tracker) sample() {
+	t.lastSample = t.snapshot.GameTime
+	t.timeline.Points = append(t.timeline.Points, TimelinePoint{
+		GameTime: t.snapshot.GameTime,
+		Teams:    t.snapshot.Teams,
+		Players:  t.snapshot.Players,
+	})
+}
+
+// objectives 从事件统计双方的龙、先锋、男爵和推塔
+func (t *Tracker) objectives() map[Team]TeamState {
+	teams := map[Team]TeamState{TeamOrder: {}, TeamChaos: {}}
+	for _, e := range t.timeline.Events {
+		team := t.EventTeam(e)
+		if team == "" {
+			continue
+		}
+		state := teams[team]
+		switch e.EventName {
+		case EventDragonKill:
+			state.Dragons = append(state.Dragons, e.DragonType)
+		case EventHeraldKill:
+			state.Heralds++
+		case EventBaronKill:
+			state.Barons++
+		case EventTurretKilled:
+			state.Turrets++
+		case EventInhibKilled:
+			state.Inhibs++
+		}
+		teams[team] = state
+	}
+	return teams
+}
+
+// structureDestroyer 推掉建筑的阵营，小兵推掉时按建筑名字判断，例如Turret_T1_L_03_A是蓝色方的塔
+func structureDestroyer(structure string, killerTeam Team) Team {
+	if killerTeam != "" {
+		return killerTeam
+	}
+	switch {
+	case strings.Contains(structure, "_T1_"):
+		return TeamChaos
+	case strings.Contains(structure, "_T2_"):
+		return TeamOrder
+	}
+	return ""
+}
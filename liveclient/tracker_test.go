@@ -0,0 +1,156 @@
+package liveclient
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+)
+
+// testGame 蓝色方Blue1~2，红色方Red1~2，自己是Blue1
+func testGame(gameTime float64) AllGameData {
+	player := func(name string, team Team, kills int) Player {
+		return Player{RiotID: name + "#CN1", RiotIDGameName: name, ChampionName: "Ahri", Team: team,
+			Scores: PlayerScores{Kills: kills}, Items: []Item{{ItemID: 1001, Price: 300, Count: 1}}}
+	}
+	return AllGameData{
+		ActivePlayer: ActivePlayer{RiotID: "Blue1#CN1", CurrentGold: 500},
+		AllPlayers: []Player{
+			player("Blue1", TeamOrder, 2), player("Blue2", TeamOrder, 1),
+			player("Red1", TeamChaos, 0), player("Red2", TeamChaos, 1),
+		},
+		GameData: GameData{GameMode: "CLASSIC", GameTime: gameTime},
+	}
+}
+
+// poll 和实际轮询一样先拉记分板，再从下一个事件id拉取事件
+func poll(t *testing.T, c *Client, tr *Tracker) (Snapshot, []Event) {
+	t.Helper()
+	data, err := c.AllGameData(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	events, err := c.EventData(context.Background(), tr.NextEventID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tr.Update(data, events)
+}
+
+func eventNames(events []Event) []string {
+	names := make([]string, 0, len(events))
+	for _, e := range events {
+		names = append(names, e.EventName)
+	}
+	return names
+}
+
+func TestTrackerEventDedup(t *testing.T) {
+	f := NewFakeServer()
+	defer f.Close()
+	c := NewClient(f.URL(), time.Second)
+	tr := NewTracker(1, time.Minute)
+
+	f.SetGameData(testGame(10))
+	f.AddEvents(Event{EventName: EventGameStart}, Event{EventName: EventChampionKill, KillerName: "Blue1"})
+	if _, events := poll(t, c, tr); !slices.Equal(eventNames(events), []string{EventGameStart, EventChampionKill}) {
+		t.Errorf("第一次轮询的新事件: %v", eventNames(events))
+	}
+	if tr.NextEventID() != 2 {
+		t.Errorf("NextEventID = %d, 应为2", tr.NextEventID())
+	}
+	// 没有新事件
+	if _, events := poll(t, c, tr); len(events) != 0 {
+		t.Errorf("没有新事件时应返回空,实际%v", eventNames(events))
+	}
+	f.AddEvents(Event{EventName: EventDragonKill, KillerName: "Red1", DragonType: "Fire"})
+	if _, events := poll(t, c, tr); !slices.Equal(eventNames(events), []string{EventDragonKill}) {
+		t.Errorf("只应返回新事件: %v", eventNames(events))
+	}
+	// 接口重复返回旧事件时按事件id去重
+	data := testGame(20)
+	snapshot, events := tr.Update(&data, []Event{{EventID: 0, EventName: EventGameStart},
+		{EventID: 2, EventName: EventDragonKill, KillerName: "Red1", DragonType: "Fire"}})
+	if len(events) != 0 {
+		t.Errorf("重复事件不应算作新事件: %v", eventNames(events))
+	}
+	if n := len(tr.Timeline().Events); n != 3 {
+		t.Errorf("时间线应有3个事件,实际%d个", n)
+	}
+	if got := snapshot.Teams[TeamChaos].Dragons; !slices.Equal(got, []string{"Fire"}) {
+		t.Errorf("重复的小龙不应重复计数: %v", got)
+	}
+}
+
+func TestTrackerObjectives(t *testing.T) {
+	f := NewFakeServer()
+	defer f.Close()
+	c := NewClient(f.URL(), time.Second)
+	tr := NewTracker(1, time.Minute)
+	f.SetGameData(testGame(1500))
+	f.AddEvents(
+		Event{EventName: EventGameStart},
+		Event{EventName: EventDragonKill, KillerName: "Blue2", DragonType: "Earth"},
+		Event{EventName: EventDragonKill, KillerName: "Red1#CN1", DragonType: "Water"},
+		Event{EventName: EventDragonKill, KillerName: "Blue1", DragonType: "Fire"},
+		Event{EventName: EventHeraldKill, KillerName: "Red2"},
+		Event{EventName: EventBaronKill, KillerName: "Blue1#CN1"},
+		// 英雄推塔按击杀者阵营
+		Event{EventName: EventTurretKilled, KillerName: "Blue1", TurretKilled: "Turret_T2_R_03_A"},
+		// 小兵推塔按塔的名字：T1是蓝色方的塔，被红色方推掉
+		Event{EventName: EventTurretKilled, KillerName: "Minion_T200L0S03N0003", TurretKilled: "Turret_T1_L_03_A"},
+		Event{EventName: EventTurretKilled, KillerName: "Minion_T100L1S03N0004", TurretKilled: "Turret_T2_C_05_A"},
+		Event{EventName: EventInhibKilled, KillerName: "Minion_T100L1S03N0005", InhibKilled: "Barracks_T2_C1"},
+		Event{EventName: EventChampionKill, KillerName: "Blue1", VictimName: "Red1"},
+	)
+	snapshot, _ := poll(t, c, tr)
+	want := map[Team]TeamState{
+		TeamOrder: {Kills: 3, ItemGold: 600, Dragons: []string{"Earth", "Fire"}, Barons: 1, Turrets: 2, Inhibs: 1},
+		TeamChaos: {Kills: 1, ItemGold: 600, Dragons: []string{"Water"}, Heralds: 1, Turrets: 1},
+	}
+	for team, w := range want {
+		got := snapshot.Teams[team]
+		if got.Kills != w.Kills || got.ItemGold != w.ItemGold || !slices.Equal(got.Dragons, w.Dragons) ||
+			got.Heralds != w.Heralds || got.Barons != w.Barons || got.Turrets != w.Turrets || got.Inhibs != w.Inhibs {
+			t.Errorf("%s = %+v, 应为%+v", team, got, w)
+		}
+	}
+	if snapshot.Self != "Blue1#CN1" || snapshot.SelfTeam != TeamOrder || snapshot.SelfGold != 500 {
+		t.Errorf("自己的信息不对: %s %s %v", snapshot.Self, snapshot.SelfTeam, snapshot.SelfGold)
+	}
+}
+
+func TestTrackerTimeline(t *testing.T) {
+	f := NewFakeServer()
+	defer f.Close()
+	c := NewClient(f.URL(), time.Second)
+	tr := NewTracker(42, time.Minute)
+	f.AddEvents(Event{EventName: EventGameStart})
+	// 60秒采样一次：10、70、130，最后的140在取时间线时补上
+	for _, gameTime := range []float64{10, 40, 69, 70, 100, 130, 140} {
+		f.SetGameData(testGame(gameTime))
+		poll(t, c, tr)
+	}
+	f.AddEvents(Event{EventName: EventGameEnd, Result: "Win"})
+	poll(t, c, tr)
+
+	timeline := tr.Timeline()
+	var times []float64
+	for _, p := range timeline.Points {
+		times = append(times, p.GameTime)
+	}
+	if !slices.Equal(times, []float64{10, 70, 130, 140}) {
+		t.Errorf("采样时间 = %v", times)
+	}
+	if timeline.GameID != 42 || timeline.GameMode != "CLASSIC" || timeline.Self != "Blue1#CN1" ||
+		timeline.SelfTeam != TeamOrder {
+		t.Errorf("时间线信息不对: %+v", timeline)
+	}
+	if timeline.Result != "Win" {
+		t.Errorf("Result = %q, 应为Win", timeline.Result)
+	}
+	// 再次取时间线不会重复补采样
+	if n := len(tr.Timeline().Points); n != 4 {
+		t.Errorf("再次取时间线后有%d个采样点", n)
+	}
+}
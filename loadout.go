@@ -0,0 +1,205 @@
+package LOLTalentScout
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"go.uber.org/zap"
+	"main.go/config"
+	"main.go/lcu"
+	"main.go/lcu/models"
+	"main.go/loadout"
+	"main.go/runes"
+	"main.go/staticdata"
+	"main.go/store"
+)
+
+// lockedPick 锁定的英雄和分配的位置
+type lockedPick struct {
+	championID int
+	position   string // 匹配模式为空
+}
+
+// onChampSelectUpdate 英雄选择阶段会话变化，锁定英雄(或大乱斗换了英雄)后导入符文、设置召唤师技能和装备方案
+func (ts *TalentScout) onChampSelectUpdate(data any) {
+	bts, err := json.Marshal(data)
+	if err != nil {
+		return
+	}
+	session := &models.ChampSelectSessionInfo{}
+	if err = json.Unmarshal(bts, session); err != nil {
+		ts.log.Debug("解析选人会话失败", zap.Error(err))
+		return
+	}
+	pick, ok := lockedChampion(session)
+	if !ok {
+		return
+	}
+	ts.mu.Lock()
+	if ts.locked == pick {
+		ts.mu.Unlock()
+		return
+	}
+	ts.locked = pick
+	ts.mu.Unlock()
+	go ts.applyRunes(pick)
+	go ts.applyLoadout(pick)
+}
+
+// managedName 伯乐管理的符文页和装备方案的名字，例如 伯乐:亚索 中单
+func managedName(prefix string, pick lockedPick) string {
+	name := prefix + staticdata.ChampionName(pick.championID)
+	if position := runes.PositionName(pick.position); position != "" {
+		name += " " + position
+	}
+	return name
+}
+
+// lockedChampion 自己锁定的英雄，还在选择时返回false；没有选人操作的模式(大乱斗)以当前英雄为准
+func lockedChampion(session *models.ChampSelectSessionInfo) (lockedPick, bool) {
+	idx := slices.IndexFunc(session.MyTeam, func(m models.ChampSelectMember) bool {
+		return m.CellId == session.LocalPlayerCellId
+	})
+	if idx < 0 || session.MyTeam[idx].ChampionId == 0 {
+		return lockedPick{}, false
+	}
+	for _, group := range session.Actions {
+		for _, action := range group {
+			if action.ActorCellId == session.LocalPlayerCellId && action.Type == lcu.ChampSelectPatchTypePick &&
+				!action.Completed {
+				return lockedPick{}, false
+			}
+		}
+	}
+	return lockedPick{championID: session.MyTeam[idx].ChampionId, position: session.MyTeam[idx].AssignedPosition}, true
+}
+
+// applyRunes 按预设或自己的历史对局生成符文页，只修改名字带前缀的符文页，没有时在空位新建
+func (ts *TalentScout) applyRunes(pick lockedPick) {
+	conf := config.Get().Runes
+	if !conf.Enabled {
+		return
+	}
+	log := ts.log.With(zap.Int("championId", pick.championID), zap.String("position", pick.position))
+	page, ok := runes.Find(pick.championID, pick.position)
+	if !ok && conf.FromHistory {
+		games, err := store.LoadGames()
+		if err != nil {
+			log.Warn("读取缓存对局失败", zap.Error(err))
+		}
+		page, ok = runes.FromHistory(games, ts.currSummoner.Puuid, pick.championID, pick.position, conf.MinGames,
+			conf.Shards)
+	}
+	if !ok {
+		log.Debug("没有可用的符文预设和历史")
+		return
+	}
+	name := managedName(conf.PagePrefix, pick)
+	pages, err := lcu.ListPerkPages()
+	if err != nil {
+		log.Warn("查询符文页失败", zap.Error(err))
+		return
+	}
+	perkPage := page.PerkPage(name)
+	if managed, found := runes.Managed(pages, conf.PagePrefix); found {
+		perkPage.Id = managed.Id
+		err = lcu.UpdatePerkPage(perkPage)
+	} else {
+		var inventory *models.PerkInventory
+		if inventory, err = lcu.GetPerkInventory(); err == nil {
+			if runes.CustomCount(pages) >= inventory.OwnedPageCount {
+				fmt.Printf("符文页已满，没有导入%s；把一页符文改名为以\"%s\"开头后伯乐会使用这一页\n", page, conf.PagePrefix)
+				return
+			}
+			var created *models.PerkPage
+			if created, err = lcu.CreatePerkPage(perkPage); err == nil {
+				perkPage.Id = created.Id
+			}
+		}
+	}
+	if err == nil {
+		err = lcu.SetCurrentPerkPage(perkPage.Id)
+	}
+	if err != nil {
+		log.Warn("导入符文失败", zap.Error(err))
+		return
+	}
+	fmt.Printf("已导入符文 %s: %s [%s]\n", name, page, page.Source)
+}
+
+// applyLoadout 按预设设置召唤师技能和导入装备方案，预设按英雄、队列和位置选择
+func (ts *TalentScout) applyLoadout(pick lockedPick) {
+	conf := config.Get().Loadout
+	if !conf.Enabled || (!conf.Spells && !conf.ItemSets) {
+		return
+	}
+	log := ts.log.With(zap.Int("championId", pick.championID), zap.String("position", pick.position))
+	session, err := lcu.QueryGameFlowSession()
+	if err != nil {
+		log.Warn("查询游戏会话失败", zap.Error(err))
+		return
+	}
+	l, ok := loadout.Find(pick.championID, session.GameData.Queue.Id, pick.position)
+	if !ok {
+		log.Debug("没有召唤师技能和装备方案预设", zap.Int("queueId", session.GameData.Queue.Id))
+		return
+	}
+	if conf.Spells && l.SpellSource != "" {
+		required := loadout.Restriction(session.Map.PerPositionRequiredSummonerSpells, pick.position)
+		disallowed := loadout.Restriction(session.Map.PerPositionDisallowedSummonerSpells, pick.position)
+		if err = ts.applySpells(l, required, disallowed); err != nil {
+			log.Warn("设置召唤师技能失败", zap.Error(err))
+		}
+	}
+	if conf.ItemSets && l.BlockSource != "" {
+		title := managedName(conf.SetPrefix, pick)
+		if err = ts.applyItemSet(l.ItemSet(title, pick.championID, session.Map.Id), conf.SetPrefix); err != nil {
+			log.Warn("导入装备方案失败", zap.Error(err))
+		} else {
+			fmt.Printf("已导入装备方案 %s [%s]\n", title, l.BlockSource)
+		}
+	}
+}
+
+// applySpells 按地图对位置的要求调整后设置召唤师技能，和当前一样时不修改
+func (ts *TalentScout) applySpells(l loadout.Loadout, required, disallowed []models.Spell) error {
+	session, err := lcu.GetChampSelectSession()
+	if err != nil {
+		return err
+	}
+	idx := slices.IndexFunc(session.MyTeam, func(m models.ChampSelectMember) bool {
+		return m.CellId == session.LocalPlayerCellId
+	})
+	if idx < 0 {
+		return nil
+	}
+	me := session.MyTeam[idx]
+	current := [2]models.Spell{models.Spell(me.Spell1Id), models.Spell(me.Spell2Id)}
+	spells, ok := loadout.ResolveSpells(l.Spells, current, required, disallowed)
+	if !ok {
+		fmt.Println("预设的召唤师技能不符合当前模式的要求，没有修改")
+		return nil
+	}
+	if spells == current {
+		return nil
+	}
+	if err = lcu.UpdateMySelection(spells[0], spells[1]); err != nil {
+		return err
+	}
+	fmt.Printf("已设置召唤师技能 %s+%s [%s]\n", staticdata.SpellName(int(spells[0])),
+		staticdata.SpellName(int(spells[1])), l.SpellSource)
+	return nil
+}
+
+// applyItemSet 替换伯乐管理的装备方案，其他装备方案原样保留
+func (ts *TalentScout) applyItemSet(set models.ItemSet, prefix string) error {
+	sets, err := lcu.GetItemSets(ts.currSummoner.SummonerId)
+	if err != nil {
+		return err
+	}
+	if sets.ItemSets, err = loadout.MergeItemSets(sets.ItemSets, prefix, set); err != nil {
+		return err
+	}
+	return lcu.SaveItemSets(ts.currSummoner.SummonerId, *sets)
+}
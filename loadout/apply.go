@@ -0,0 +1,127 @@
+package loadout
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"main.go/lcu/models"
+)
+
+// Restriction 地图对该位置要求必须带或不能带的召唤师技能，位置不区分大小写
+func Restriction(spells models.PositionSpells, position string) []models.Spell {
+	for key, list := range spells {
+		if strings.EqualFold(key, position) {
+			return list
+		}
+	}
+	return nil
+}
+
+// ResolveSpells 按地图对位置的要求调整预设的召唤师技能：去掉不能带的，换上必须带的，空位用当前的技能补齐
+// 调整后凑不齐两个时返回false
+func ResolveSpells(preset, current [2]models.Spell, required, disallowed []models.Spell) ([2]models.Spell, bool) {
+	spells := make([]models.Spell, 0, 2)
+	add := func(s models.Spell) {
+		if s != 0 && len(spells) < 2 && !slices.Contains(spells, s) && !slices.Contains(disallowed, s) {
+			spells = append(spells, s)
+		}
+	}
+	for _, s := range preset {
+		add(s)
+	}
+	for _, s := range required {
+		if slices.Contains(spells, s) {
+			continue
+		}
+		if len(spells) < 2 {
+			spells = append(spells, s)
+			continue
+		}
+		// 替换靠后的不是必须带的技能，尽量保留D位的闪现
+		for i := len(spells) - 1; i >= 0; i-- {
+			if !slices.Contains(required, spells[i]) {
+				spells[i] = s
+				break
+			}
+		}
+	}
+	for _, s := range current {
+		add(s)
+	}
+	if len(spells) < 2 {
+		return [2]models.Spell{}, false
+	}
+	return [2]models.Spell{spells[0], spells[1]}, true
+}
+
+// ItemSet 生成只在该英雄和地图显示的装备方案，mapID为0时不限地图
+func (l Loadout) ItemSet(title string, championID, mapID int) models.ItemSet {
+	set := models.ItemSet{
+		AssociatedChampions: []int{championID},
+		AssociatedMaps:      []int{},
+		Blocks:              make([]models.ItemSetBlock, 0, len(l.Blocks)),
+		Map:                 "any",
+		Mode:                "any",
+		PreferredItemSlots:  []any{},
+		StartedFrom:         "blank",
+		Title:               title,
+		Type:                "custom",
+	}
+	if mapID != 0 {
+		set.AssociatedMaps = append(set.AssociatedMaps, mapID)
+	}
+	for i, b := range l.Blocks {
+		block := models.ItemSetBlock{Type: b.Title}
+		if block.Type == "" {
+			block.Type = fmt.Sprintf("装备%d", i+1)
+		}
+		// 连续重复的装备合并成数量
+		for _, item := range b.Items {
+			id := strconv.Itoa(int(item))
+			if n := len(block.Items); n > 0 && block.Items[n-1].Id == id {
+				block.Items[n-1].Count++
+				continue
+			}
+			block.Items = append(block.Items, models.ItemSetItem{Id: id, Count: 1})
+		}
+		set.Blocks = append(set.Blocks, block)
+	}
+	return set
+}
+
+// MergeItemSets 用set替换标题带前缀的装备方案(沿用原来的uid)，没有时追加，其他方案原样保留
+func MergeItemSets(sets []json.RawMessage, prefix string, set models.ItemSet) ([]json.RawMessage, error) {
+	merged := make([]json.RawMessage, 0, len(sets)+1)
+	for _, raw := range sets {
+		header := struct {
+			Title string `json:"title"`
+			Uid   string `json:"uid"`
+		}{}
+		if json.Unmarshal(raw, &header) == nil && strings.HasPrefix(header.Title, prefix) {
+			if set.Uid == "" {
+				set.Uid = header.Uid
+			}
+			continue
+		}
+		merged = append(merged, raw)
+	}
+	if set.Uid == "" {
+		set.Uid = newUID()
+	}
+	bts, err := json.Marshal(set)
+	if err != nil {
+		return nil, err
+	}
+	return append(merged, bts), nil
+}
+
+// newUID 随机生成装备方案的uid，格式和客户端一致
+func newUID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
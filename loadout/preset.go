@@ -0,0 +1,232 @@
+package loadout
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+	"main.go/config"
+	"main.go/lcu/models"
+	"main.go/logger"
+	"main.go/runes"
+	"main.go/staticdata"
+	"sigs.k8s.io/yaml"
+)
+
+// defaultChampion default.yaml中的预设适用于所有英雄，英雄自己的预设优先
+const defaultChampion = "default"
+
+// presetExts 预设文件的扩展名
+var presetExts = []string{".yaml", ".yml", ".json"}
+
+type (
+	// SpellRef 召唤师技能id或名字，例如 4、闪现、Flash
+	SpellRef int
+	// ItemRef 装备id或名字，例如 3031、无尽之刃
+	ItemRef int
+	// Block 装备方案中的一栏
+	Block struct {
+		Title string    `json:"title"`
+		Items []ItemRef `json:"items"` // 重复写几次表示买几个
+	}
+	// Preset 一组预设，召唤师技能和装备方案可以只写一个
+	Preset struct {
+		Role   string     `json:"role"`   // top/jungle/middle/bottom/utility，为空时适用于所有位置
+		Queues []int      `json:"queues"` // 只用于这些队列，例如大乱斗450，为空时适用于所有队列
+		Spells []SpellRef `json:"spells"` // 两个召唤师技能，依次为D和F
+		Blocks []Block    `json:"blocks"` // 装备方案
+	}
+	// presetFile 一个英雄的预设文件
+	presetFile struct {
+		Champion string   `json:"champion"` // 英雄id或名字，为空时使用文件名，default表示所有英雄
+		Presets  []Preset `json:"presets"`
+	}
+	// library 英雄id -> 预设，0为default.yaml
+	library map[int][]source
+	// source 预设和所在文件
+	source struct {
+		Preset
+		file string
+	}
+	// Loadout 一局要用的召唤师技能和装备方案，来源为空表示没有对应的预设
+	Loadout struct {
+		Spells      [2]models.Spell
+		SpellSource string
+		Blocks      []Block
+		BlockSource string
+	}
+)
+
+var (
+	mu      = sync.RWMutex{}
+	presets = library{}
+)
+
+// UnmarshalJSON 支持数字和名字
+func (r *SpellRef) UnmarshalJSON(bts []byte) error {
+	id, err := staticdata.ParseRef(staticdata.KindSpell, bts)
+	*r = SpellRef(id)
+	return err
+}
+
+// UnmarshalJSON 支持数字和名字
+func (r *ItemRef) UnmarshalJSON(bts []byte) error {
+	id, err := staticdata.ParseRef(staticdata.KindItem, bts)
+	*r = ItemRef(id)
+	return err
+}
+
+// Dir 预设目录，相对路径以当前配置文件所在目录为准
+func Dir(c config.LoadoutConf) string {
+	if filepath.IsAbs(c.PresetDir) {
+		return c.PresetDir
+	}
+	return filepath.Join(filepath.Dir(config.Path()), c.PresetDir)
+}
+
+// Init 加载预设目录下的全部预设，单个文件出错不影响其他文件
+func Init(c config.LoadoutConf) error {
+	loaded, errs, err := loadDir(c)
+	if err != nil {
+		return err
+	}
+	mu.Lock()
+	presets = loaded
+	mu.Unlock()
+	for _, loadErr := range errs {
+		logger.L().Warn("加载召唤师技能和装备方案预设失败", zap.Error(loadErr))
+	}
+	return nil
+}
+
+// Validate 试加载预设目录，返回加载失败的原因，不影响当前已加载的预设
+func Validate(c config.LoadoutConf) error {
+	_, errs, err := loadDir(c)
+	if err != nil {
+		return err
+	}
+	return errors.Join(errs...)
+}
+
+// Find 英雄在该队列和位置要用的召唤师技能和装备方案，分别取最匹配的预设：
+// 指定了队列的优先，其次是指定了位置的，同样匹配时英雄自己的预设优先于default.yaml
+func Find(championID, queueID int, position string) (Loadout, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	candidates := append(slices.Clone(presets[championID]), presets[0]...)
+	best := func(has func(Preset) bool) (source, bool) {
+		bestScore, res := -1, source{}
+		for _, s := range candidates {
+			if !has(s.Preset) || !s.matches(queueID, position) {
+				continue
+			}
+			score := 0
+			if len(s.Queues) > 0 {
+				score += 2
+			}
+			if s.Role != "" {
+				score++
+			}
+			if score > bestScore {
+				bestScore, res = score, s
+			}
+		}
+		return res, bestScore >= 0
+	}
+	l := Loadout{}
+	if s, ok := best(func(p Preset) bool { return len(p.Spells) > 0 }); ok {
+		l.Spells = [2]models.Spell{models.Spell(s.Spells[0]), models.Spell(s.Spells[1])}
+		l.SpellSource = "预设" + s.file
+	}
+	if s, ok := best(func(p Preset) bool { return len(p.Blocks) > 0 }); ok {
+		l.Blocks = s.Blocks
+		l.BlockSource = "预设" + s.file
+	}
+	return l, l.SpellSource != "" || l.BlockSource != ""
+}
+
+// matches 预设是否适用于该队列和位置
+func (p Preset) matches(queueID int, position string) bool {
+	return (len(p.Queues) == 0 || slices.Contains(p.Queues, queueID)) && (p.Role == "" || p.Role == position)
+}
+
+// loadDir 加载预设目录，errs是单个文件的错误，err是目录读取错误
+func loadDir(c config.LoadoutConf) (loaded library, errs []error, err error) {
+	loaded = library{}
+	if !c.Enabled {
+		return loaded, nil, nil
+	}
+	dir := Dir(c)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return loaded, nil, nil
+		}
+		return nil, nil, err
+	}
+	for _, entry := range entries {
+		if entry.IsDir() || !slices.Contains(presetExts, strings.ToLower(filepath.Ext(entry.Name()))) {
+			continue
+		}
+		championID, list, loadErr := loadFile(filepath.Join(dir, entry.Name()))
+		if loadErr != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", entry.Name(), loadErr))
+			continue
+		}
+		for _, p := range list {
+			loaded[championID] = append(loaded[championID], source{Preset: p, file: entry.Name()})
+		}
+	}
+	return loaded, errs, nil
+}
+
+// loadFile 读取并检查一个预设文件，default.yaml的英雄id为0
+func loadFile(path string) (int, []Preset, error) {
+	bts, err := os.ReadFile(path)
+	if err != nil {
+		return 0, nil, err
+	}
+	f := presetFile{}
+	if err = yaml.UnmarshalStrict(bts, &f); err != nil {
+		return 0, nil, err
+	}
+	if f.Champion == "" {
+		f.Champion = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+	championID := 0
+	if !strings.EqualFold(f.Champion, defaultChampion) {
+		if championID, err = strconv.Atoi(f.Champion); err != nil {
+			e, ok := staticdata.Find(staticdata.KindChampion, f.Champion)
+			if !ok {
+				return 0, nil, fmt.Errorf("未知的英雄:%s", f.Champion)
+			}
+			championID = e.ID
+		}
+	}
+	if len(f.Presets) == 0 {
+		return 0, nil, errors.New("没有预设")
+	}
+	for i, p := range f.Presets {
+		if p.Role != "" && !slices.Contains(runes.Positions, p.Role) {
+			return 0, nil, fmt.Errorf("第%d组的位置%s未知,可选:%v", i+1, p.Role, runes.Positions)
+		}
+		if len(p.Spells) == 0 && len(p.Blocks) == 0 {
+			return 0, nil, fmt.Errorf("第%d组没有召唤师技能也没有装备方案", i+1)
+		}
+		if len(p.Spells) > 0 && (len(p.Spells) != 2 || p.Spells[0] == p.Spells[1]) {
+			return 0, nil, fmt.Errorf("第%d组应有两个不同的召唤师技能", i+1)
+		}
+		for j, b := range p.Blocks {
+			if len(b.Items) == 0 {
+				return 0, nil, fmt.Errorf("第%d组的第%d栏装备为空", i+1, j+1)
+			}
+		}
+	}
+	return championID, f.Presets, nil
+}
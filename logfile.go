@@ -0,0 +1,53 @@
+package LOLTalentScout
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// teeStdout 把命令行输出的报告同时写入文件，文件中的每行带上时间，返回的函数用于还原命令行输出并关闭文件
+// 诊断日志输出到stderr和log.file，不写入这里
+func teeStdout(path string) (func(), error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, err
+	}
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	r, w, err := os.Pipe()
+	if err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+	stdout := os.Stdout
+	os.Stdout = w
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		// 按行读取，不限制行的长度，超长的行也不会让读取停下来导致命令行输出阻塞
+		reader := bufio.NewReader(r)
+		for {
+			line, err := reader.ReadString('\n')
+			if line != "" {
+				line = strings.TrimSuffix(line, "\n")
+				_, _ = fmt.Fprintln(stdout, line)
+				_, _ = fmt.Fprintln(file, time.Now().Format("2006-01-02 15:04:05.000"), line)
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+	return func() {
+		os.Stdout = stdout
+		_ = w.Close()
+		<-done
+		_ = r.Close()
+		_ = file.Close()
+	}, nil
+}
@@ -0,0 +1,80 @@
+package logger
+
+import (
+	"os"
+	"sync"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"gopkg.in/natefinch/lumberjack.v2"
+	"main.go/config"
+)
+
+// 诊断日志：命令行使用便于阅读的格式输出到stderr，文件使用json格式并按大小切割
+// 给用户看的评分报告、赛后报告等仍然直接输出到stdout，不经过这里
+
+var (
+	mu     = sync.RWMutex{}
+	global = newConsole(zapcore.InfoLevel)
+)
+
+// Init 按配置创建全局日志
+func Init(c config.LogConf) error {
+	level, err := zapcore.ParseLevel(c.Level)
+	if err != nil {
+		return err
+	}
+	var cores []zapcore.Core
+	if c.Console {
+		cores = append(cores, consoleCore(level))
+	}
+	if c.File != "" {
+		writer := &lumberjack.Logger{
+			Filename:   c.File,
+			MaxSize:    c.MaxSizeMB,
+			MaxBackups: c.MaxBackups,
+			MaxAge:     c.MaxAgeDays,
+		}
+		encoderConf := zap.NewProductionEncoderConfig()
+		encoderConf.EncodeTime = zapcore.ISO8601TimeEncoder
+		cores = append(cores, zapcore.NewCore(zapcore.NewJSONEncoder(encoderConf), zapcore.AddSync(writer), level))
+	}
+	Set(zap.New(zapcore.NewTee(cores...), zap.AddCaller()))
+	return nil
+}
+
+// L 全局日志
+func L() *zap.Logger {
+	mu.RLock()
+	defer mu.RUnlock()
+	return global
+}
+
+// Set 替换全局日志
+func Set(l *zap.Logger) {
+	mu.Lock()
+	global = l
+	mu.Unlock()
+}
+
+// Sync 退出前写入缓冲中的日志
+func Sync() {
+	_ = L().Sync()
+}
+
+// Lobby 带上对局id和客户端状态，方便在日志中筛选同一个大厅的记录
+func Lobby(l *zap.Logger, gameID int64, phase string) *zap.Logger {
+	return l.With(zap.Int64("gameId", gameID), zap.String("phase", phase))
+}
+
+// newConsole 只输出到命令行的日志，加载配置前使用
+func newConsole(level zapcore.Level) *zap.Logger {
+	return zap.New(consoleCore(level))
+}
+
+func consoleCore(level zapcore.Level) zapcore.Core {
+	encoderConf := zap.NewDevelopmentEncoderConfig()
+	encoderConf.EncodeTime = zapcore.TimeEncoderOfLayout("15:04:05")
+	encoderConf.EncodeLevel = zapcore.CapitalLevelEncoder
+	return zapcore.NewCore(zapcore.NewConsoleEncoder(encoderConf), zapcore.Lock(os.Stderr), level)
+}
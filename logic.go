@@ -1,13 +1,16 @@
 package LOLTalentScout
 
 import (
-	"fmt"
 	"github.com/avast/retry-go"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
+	"main.go/config"
 	"main.go/lcu"
 	"main.go/lcu/models"
+	"main.go/logger"
 	"main.go/scores"
+	"main.go/script"
+	"main.go/store"
 	"sync"
 	"time"
 )
@@ -22,6 +25,7 @@ const (
 	GameStateInGame      GameState = "inGame"
 	GameStateOther       GameState = "other"
 	GameStateMatchmaking GameState = "Matchmaking"
+	GameStateEndOfGame   GameState = "EndOfGame"
 )
 
 type lcuWsEvt string
@@ -33,14 +37,13 @@ const (
 )
 
 const (
-	defaultScore       = 100 // 默认分数
 	minGameDurationSec = 15 * 60
 )
 
 func acceptGame() error {
 	err := lcu.AcceptGame()
 	if err != nil {
-		fmt.Println("自动接受对局失败")
+		logger.L().Error("自动接受对局失败", zap.Error(err))
 		return err
 	}
 	return nil
@@ -61,6 +64,15 @@ func getTeamUsers() (string, []int64, error) {
 	return conversationID, summonerIDList, nil
 }
 
+// currQueueID 当前对局的队列id，查询失败时为0
+func currQueueID() int {
+	session, err := lcu.QueryGameFlowSession()
+	if err != nil {
+		return 0
+	}
+	return session.GameData.Queue.Id
+}
+
 // listSummoner 通过用户id列表拿到所有个人信息
 func listSummoner(summonerIDList []int64) (map[int64]*models.Summoner, error) {
 	list, err := lcu.ListSummoner(summonerIDList)
@@ -87,18 +99,27 @@ func getSummonerIDListFromConversationMsgList(msgList []models.ConversationMsg)
 	return summonerIDList
 }
 
+// gameHistory 用户历史战绩
+type gameHistory struct {
+	games  []models.GameInfo // 参与评分的对局
+	recent []models.GameInfo // 常规队列的全部对局，包含重开等短时间对局
+	isARAM bool              // 是否大乱斗玩家
+	depth  int               // 战绩记录场次，新号往往不足20场
+}
+
 // listGameHistory 根据用户puuid拿到历史战绩
-func listGameHistory(puuid string) ([]models.GameInfo, bool, error) {
+func listGameHistory(puuid string) (*gameHistory, error) {
 	//查询最近20把
 	limit := 20
 	fmtList := make([]models.GameInfo, 0, limit)
+	recentList := make([]models.GameInfo, 0, limit)
 	//最近20把如果有10把是大乱斗，那么就称为大乱斗玩家，评分可能会有差异
 	countARAM := 0
 	isARAM := false
 	resp, err := lcu.ListGamesByPUUID(puuid, 0, limit)
 	if err != nil {
-		fmt.Println("查询用户战绩失败:", puuid)
-		return nil, false, err
+		logger.L().Warn("查询用户战绩失败", zap.Error(err), zap.String("puuid", puuid))
+		return nil, err
 	}
 	for _, gameItem := range resp.Games.Games {
 
@@ -111,6 +132,7 @@ func listGameHistory(puuid string) ([]models.GameInfo, bool, error) {
 		if gameItem.GameMode == models.GameModeARAM {
 			countARAM++
 		}
+		recentList = append(recentList, gameItem)
 		if gameItem.GameDuration < minGameDurationSec {
 			continue
 		}
@@ -124,24 +146,47 @@ func listGameHistory(puuid string) ([]models.GameInfo, bool, error) {
 	if countARAM >= 10 {
 		isARAM = true
 	}
-	return fmtList, isARAM, nil
+	return &gameHistory{
+		games:  fmtList,
+		recent: recentList,
+		isARAM: isARAM,
+		depth:  len(resp.Games.Games),
+	}, nil
 }
 
-// GetUserScore 从个人信息得到用户最近评分
-func GetUserScore(summoner *models.Summoner) (*scores.UserScore, error) {
+// GetUserScore 从个人信息得到用户最近评分，queueID用来选择评分模型
+func GetUserScore(summoner *models.Summoner, queueID int) (*scores.UserScore, error) {
 	// 获取最近20场战绩列表
-	gameList, isARAM, err := listGameHistory(summoner.Puuid)
+	history, err := listGameHistory(summoner.Puuid)
 	summonerID := summoner.SummonerId
 	userScoreInfo := &scores.UserScore{
 		SummonerID:   summonerID,
+		Puuid:        summoner.Puuid,
 		SummonerName: summoner.GameName,
-		Score:        defaultScore,
-		IsARAM:       isARAM,
 	}
+	// 评分完成后交给脚本加标签
+	defer script.OnPlayerScored(userScoreInfo)
+	// 获取单双排和灵活组排段位
+	rankedKnown := false
+	if rankedStats, rankErr := lcu.GetRankedStats(summoner.Puuid); rankErr != nil {
+		logger.L().Warn("获取用户段位失败", zap.Error(rankErr), zap.Int64("id", summonerID))
+	} else {
+		userScoreInfo.SoloRank = scores.NewRankInfo(rankedStats.QueueMap[models.GameQueueTypeRankSolo])
+		userScoreInfo.FlexRank = scores.NewRankInfo(rankedStats.QueueMap[models.GameQueueTypeRankFlex])
+		rankedKnown = true
+	}
+	// 没有可用对局时评分为先验评分，评级显示为未知
+	shrinkageConf := config.Get().Shrinkage
+	prior := scores.PriorScore(userScoreInfo.SoloRank, userScoreInfo.FlexRank, shrinkageConf)
+	userScoreInfo.SetScore(scores.ScoreStat{}, prior, shrinkageConf)
 	if err != nil {
-		fmt.Println("获取用户战绩失败", zap.Error(err), zap.Int64("id", summonerID))
+		logger.L().Warn("获取用户战绩失败", zap.Error(err), zap.Int64("id", summonerID))
 		return userScoreInfo, nil
 	}
+	gameList := history.games
+	userScoreInfo.IsARAM = history.isARAM
+	userScoreInfo.History = history.recent
+	userScoreInfo.Tags = scores.CalcBehaviorTags(history.recent, config.Get().Behavior)
 	// 获取每一局战绩KDA
 	g := errgroup.Group{}
 	gameSummaryList := make([]models.GameSummary, 0, len(gameList))
@@ -156,14 +201,9 @@ func GetUserScore(summoner *models.Summoner) (*scores.UserScore, error) {
 		}
 		//从20对局信息里拿到我们需要的信息反序列化到lcu.GameSummary加入到gameSummaryList
 		g.Go(func() error {
-			var gameSummary *models.GameSummary
-			err = retry.Do(func() error {
-				var tmpErr error
-				gameSummary, tmpErr = lcu.QueryGameSummary(info.GameId)
-				return tmpErr
-			}, retry.Delay(time.Millisecond*10), retry.Attempts(5))
+			gameSummary, err := queryGameSummary(info.GameId)
 			if err != nil {
-				fmt.Println("获取游戏对局详细信息失败", zap.Error(err), zap.Int64("id", info.GameId))
+				logger.L().Warn("获取游戏对局详细信息失败", zap.Error(err), zap.Int64("id", info.GameId))
 				return nil
 			}
 			mu.Lock()
@@ -175,93 +215,92 @@ func GetUserScore(summoner *models.Summoner) (*scores.UserScore, error) {
 	userScoreInfo.CurrKDA = currKDAList
 	err = g.Wait() //等待所有协程退出
 	if err != nil {
-		fmt.Println("获取用户详细战绩失败", zap.Error(err), zap.Int64("id", summonerID))
+		logger.L().Warn("获取用户详细战绩失败", zap.Error(err), zap.Int64("id", summonerID))
 		return userScoreInfo, nil
 	}
-	//最近20场总分
-	var totalScore float64 = 0
-	totalGameCount := 0
-	type gameScoreWithWeight struct {
-		score       float64
-		isCurrTimes bool //是否最近五小时
+	conf := config.Get()
+	weighting := scores.NewWeighting(conf.Weighting)
+	scorer := scores.NewQueueScorer(conf.Scoring, queueID, weighting)
+	gameScoreList, ok := scoreGames(scorer, summoner, gameSummaryList)
+	if !ok {
+		return userScoreInfo, nil
 	}
-	// gameWeightScoreList := make([]gameScoreWithWeight, 0, len(gameSummaryList))
-	nowTime := time.Now()
-	// 根据权重分析每一局战绩计算得分
-	currTimeScoreList := make([]float64, 0, 10)  //最近五小时分数列表
-	otherGameScoreList := make([]float64, 0, 10) //其他时间分数列表
-	for _, gameSummary := range gameSummaryList {
-		//得到用户该局分数
-		gameScore, err := scores.CalcUserGameScore(summonerID, gameSummary)
+	// 根据权重分析每一局战绩计算得分，样本少的玩家向先验评分收缩
+	userScoreInfo.Scorer = scorer.Name()
+	userScoreInfo.SetScore(scorer.Aggregate(gameScoreList, time.Now()), prior, shrinkageConf)
+	// 对比模型只用于展示
+	if conf.Scoring.Compare != "" && conf.Scoring.Compare != scorer.Name() {
+		compareScorer, err := scores.NewScorer(conf.Scoring.Compare, weighting)
 		if err != nil {
-			fmt.Println("游戏战绩计算用户得分失败", zap.Error(err), zap.Int64("summonerID", summonerID),
-				zap.Int64("gameID", gameSummary.GameId))
-			return userScoreInfo, nil
+			logger.L().Warn("创建对比评分模型失败", zap.Error(err))
+		} else if compareList, ok := scoreGames(compareScorer, summoner, gameSummaryList); ok {
+			compareScore, _, _ := scores.Shrink(compareScorer.Aggregate(compareList, time.Now()), prior, shrinkageConf)
+			userScoreInfo.Compare = &scores.CompareScore{Scorer: compareScorer.Name(), Score: compareScore}
 		}
-		weightScoreItem := gameScoreWithWeight{
-			score:       gameScore.Value(),
-			isCurrTimes: nowTime.Before(gameSummary.GameCreationDate.Add(time.Hour * 5)),
-		}
-		if weightScoreItem.isCurrTimes {
-			currTimeScoreList = append(currTimeScoreList, gameScore.Value())
-		} else {
-			otherGameScoreList = append(otherGameScoreList, gameScore.Value())
+	}
+	gameStatList := make([]scores.GameStat, 0, len(gameSummaryList))
+	for i, gameSummary := range gameSummaryList {
+		if gameStat, statErr := scores.CalcUserGameStat(summonerID, summoner.Puuid, gameSummary); statErr == nil {
+			gameStat.Score = gameScoreList[i].Score
+			gameStatList = append(gameStatList, gameStat)
 		}
-		totalGameCount++
-		totalScore += gameScore.Value()
-
 	}
+	// 小号识别
+	userScoreInfo.Smurf = scores.CalcSmurf(scores.SmurfInput{
+		SummonerLevel: summoner.SummonerLevel,
+		RankedGames:   userScoreInfo.SoloRank.Games() + userScoreInfo.FlexRank.Games(),
+		RankedKnown:   rankedKnown,
+		HistoryGames:  history.depth,
+		Games:         gameStatList,
+	}, conf.Smurf)
+	return userScoreInfo, nil
+}
 
-	totalGameScore := 0.0      //总得分
-	totalTimeScore := 0.0      //最近五小时得分
-	avgTimeScore := 0.0        //最近五小时平均得分
-	totalOtherGameScore := 0.0 //其他时间得分
-	avgOtherGameScore := 0.0   //其他时间平均得分
-
-	for _, score := range currTimeScoreList {
-		totalTimeScore += score
-		totalGameScore += score
-	}
-	for _, score := range otherGameScoreList {
-		totalOtherGameScore += score
-		totalGameScore += score
-	}
-	if totalTimeScore > 0 {
-		avgTimeScore = totalTimeScore / float64(len(currTimeScoreList))
-	}
-	if totalOtherGameScore > 0 {
-		avgOtherGameScore = totalOtherGameScore / float64(len(otherGameScoreList))
-	}
-	totalGameAvgScore := 0.0
-	if totalGameCount > 0 {
-		totalGameAvgScore = totalGameScore / float64(totalGameCount)
-	}
-	weightTotalScore := 0.0
-	// 最近五小时
-	{
-		if len(currTimeScoreList) == 0 {
-			//如果最近没打，就把近二十场平均得分当作最近五小时平均得分
-			weightTotalScore += .8 * totalGameAvgScore
-		} else {
-			weightTotalScore += .8 * avgTimeScore
+// scoreGames 用评分模型计算每一局得分，和gameSummaryList一一对应
+func scoreGames(scorer scores.Scorer, summoner *models.Summoner, gameSummaryList []models.GameSummary) (
+	[]scores.GameScoreItem, bool) {
+	gameScoreList := make([]scores.GameScoreItem, 0, len(gameSummaryList))
+	for _, gameSummary := range gameSummaryList {
+		//得到用户该局分数
+		participantID, err := scores.FindParticipantID(gameSummary, summoner.SummonerId, summoner.Puuid)
+		var gameScore float64
+		if err == nil {
+			gameScore, err = scorer.GameScore(participantID, gameSummary)
 		}
-	}
-	// 其他时间
-	{
-		if len(otherGameScoreList) == 0 {
-			//如果五小时玩了20把，就把近二十场平均得分当作其他时间平均得分，其实不可能五小时打20把
-			weightTotalScore += .2 * totalGameAvgScore
-		} else {
-			weightTotalScore += .2 * avgOtherGameScore
+		if err != nil {
+			logger.L().Warn("游戏战绩计算用户得分失败", zap.Error(err), zap.Int64("summonerID", summoner.SummonerId),
+				zap.Int64("gameID", gameSummary.GameId), zap.String("scorer", scorer.Name()))
+			return nil, false
 		}
+		gameScoreList = append(gameScoreList, scores.GameScoreItem{
+			Score:            gameScore,
+			GameCreationDate: gameSummary.GameCreationDate,
+			GameVersion:      gameSummary.GameVersion,
+		})
 	}
-	// 计算平均值返回
-	// userScoreInfo.Score = totalScore / float64(totalGameCount)
-	if len(gameSummaryList) == 0 {
-		weightTotalScore = defaultScore
+	return gameScoreList, true
+}
+
+// queryGameSummary 查询对局详情，优先读取本地缓存
+func queryGameSummary(gameID int64) (*models.GameSummary, error) {
+	if gameSummary, ok := store.LoadGame(gameID); ok {
+		gameCacheRequests.Inc("hit")
+		return gameSummary, nil
 	}
-	userScoreInfo.Score = weightTotalScore
-	return userScoreInfo, nil
+	gameCacheRequests.Inc("miss")
+	var gameSummary *models.GameSummary
+	err := retry.Do(func() error {
+		var tmpErr error
+		gameSummary, tmpErr = lcu.QueryGameSummary(gameID)
+		return tmpErr
+	}, retry.Delay(time.Millisecond*10), retry.Attempts(5))
+	if err != nil {
+		return nil, err
+	}
+	if err = store.SaveGame(gameSummary); err != nil {
+		logger.L().Warn("缓存对局详情失败", zap.Error(err), zap.Int64("gameID", gameID))
+	}
+	return gameSummary, nil
 }
 
 // GetAllUsersFromSession 对局开始后通过session拿到队友信息
@@ -315,11 +354,24 @@ func GetAllUsersFromSession(selfID int64, session *models.GameFlowSession) (self
 
 // SendMessage 每隔两秒发送马匹消息
 func SendMessage(msgList []string, sessionId string) {
-	m := "LOL伯乐正在寻找千里马..."
-	_ = lcu.SendConversationMsg(m, sessionId)
+	sendMessages(reportHeader, msgList, sessionId)
+}
+
+// sendMessages 先发送标题，再间隔发送每条消息，连续发送会导致LOL禁言
+func sendMessages(header string, msgList []string, conversationID string) {
+	sendMessage(header, conversationID)
 	for _, msg := range msgList {
 		time.Sleep(4 * time.Second)
-		_ = lcu.SendConversationMsg(msg, sessionId)
+		sendMessage(msg, conversationID)
 	}
-	return
+}
+
+// sendMessage 发送一条消息并记录是否成功
+func sendMessage(msg string, conversationID string) {
+	if err := lcu.SendConversationMsg(msg, conversationID); err != nil {
+		chatMessages.Inc("dropped")
+		logger.L().Warn("发送消息失败", zap.Error(err), zap.String("conversationID", conversationID))
+		return
+	}
+	chatMessages.Inc("sent")
 }
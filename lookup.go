@@ -0,0 +1,103 @@
+package LOLTalentScout
+
+import (
+	"errors"
+	"fmt"
+	"main.go/config"
+	"main.go/initialize"
+	"main.go/lcu"
+	"main.go/lcu/models"
+	"main.go/scores"
+	"slices"
+	"time"
+)
+
+// ErrLCUNotFound 没有检测到LOL客户端或者连接失败
+var ErrLCUNotFound = errors.New("未检测到LOL客户端")
+
+// HistoryItem 参与评分的一局对局
+type HistoryItem struct {
+	GameID     int64     `json:"gameID"`
+	Time       time.Time `json:"time"`
+	QueueID    int       `json:"queueID"`
+	ChampionID int       `json:"championID"`
+	KDA        [3]int    `json:"kda"`
+	Win        bool      `json:"win"`
+	Score      float64   `json:"score"`
+	Scorer     string    `json:"scorer"`
+	Reasons    string    `json:"reasons,omitempty"` // 经典模型的得分原因
+}
+
+// ConnectLCU 连接LOL客户端，没有指定端口和token时从客户端进程读取
+func ConnectLCU(port int, token string) error {
+	if port == 0 || token == "" {
+		token, port = initialize.NewCertificate()
+	}
+	if port == 0 || token == "" {
+		return ErrLCUNotFound
+	}
+	lcu.InitCli(port, token)
+	if _, err := lcu.GetCurrSummoner(); err != nil {
+		return fmt.Errorf("%w: %v", ErrLCUNotFound, err)
+	}
+	return nil
+}
+
+// FindSummoner 按 名字#编号 查询召唤师
+func FindSummoner(riotID string) (*models.Summoner, error) {
+	summoner, err := lcu.QuerySummonerByName(riotID)
+	if err != nil {
+		return nil, err
+	}
+	if summoner.Puuid == "" {
+		return nil, fmt.Errorf("未找到召唤师:%s", riotID)
+	}
+	return summoner, nil
+}
+
+// ListScoredHistory 列出召唤师最近参与评分的对局及每局得分，从新到旧
+func ListScoredHistory(summoner *models.Summoner, queueID int) ([]HistoryItem, error) {
+	history, err := listGameHistory(summoner.Puuid)
+	if err != nil {
+		return nil, err
+	}
+	conf := config.Get()
+	scorer := scores.NewQueueScorer(conf.Scoring, queueID, scores.NewWeighting(conf.Weighting))
+	items := make([]HistoryItem, 0, len(history.games))
+	for _, info := range history.games {
+		gameSummary, err := queryGameSummary(info.GameId)
+		if err != nil {
+			return nil, err
+		}
+		participantID, err := scores.FindParticipantID(*gameSummary, summoner.SummonerId, summoner.Puuid)
+		if err != nil {
+			return nil, err
+		}
+		gameScore, err := scorer.GameScore(participantID, *gameSummary)
+		if err != nil {
+			return nil, err
+		}
+		stats := info.Participants[0].Stats
+		item := HistoryItem{
+			GameID:     info.GameId,
+			Time:       info.GameCreationDate,
+			QueueID:    int(info.QueueId),
+			ChampionID: int(info.Participants[0].ChampionId),
+			KDA:        [3]int{stats.Kills, stats.Deaths, stats.Assists},
+			Win:        stats.Win,
+			Score:      gameScore,
+			Scorer:     scorer.Name(),
+		}
+		if scorer.Name() == scores.ScorerClassic {
+			if detail, err := scores.CalcParticipantGameScore(participantID, *gameSummary,
+				scores.CurrCalcScoreConf()); err == nil {
+				item.Reasons = detail.Reasons2String()
+			}
+		}
+		items = append(items, item)
+	}
+	slices.SortFunc(items, func(a, b HistoryItem) int {
+		return b.Time.Compare(a.Time)
+	})
+	return items, nil
+}
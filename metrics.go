@@ -0,0 +1,21 @@
+package LOLTalentScout
+
+import "main.go/metrics"
+
+// 分析阶段，用作指标标签
+const (
+	phaseChampSelect = "champSelect"
+	phaseInGame      = "inGame"
+	phasePostGame    = "postGame"
+)
+
+var (
+	wsReconnects      = metrics.NewCounter("lcu_websocket_reconnects_total", "和客户端的websocket重连次数")
+	gameCacheRequests = metrics.NewCounter("game_cache_requests_total", "查询对局详情时本地缓存的命中情况，result为hit或miss",
+		"result")
+	analysisDuration = metrics.NewHistogram("analysis_duration_seconds", "各阶段分析耗时，从开始查询到输出报告",
+		metrics.DurationBuckets, "phase")
+	chatMessages = metrics.NewCounter("chat_messages_total", "发送到聊天室的消息，result为sent或dropped", "result")
+	autoAccepts  = metrics.NewCounter("auto_accept_total", "对局确认次数，result为accepted、skipped(未开启)或error",
+		"result")
+)
@@ -0,0 +1,212 @@
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// 进程内的指标，以Prometheus文本格式输出，不依赖外部服务
+
+// 常用的耗时分桶(秒)
+var (
+	LatencyBuckets  = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5}
+	DurationBuckets = []float64{0.5, 1, 2, 5, 10, 20, 30, 60, 120}
+)
+
+// collector 一个指标
+type collector interface {
+	write(w *bufio.Writer)
+}
+
+var (
+	registryMu = sync.Mutex{}
+	registry   []collector
+)
+
+func register(c collector) {
+	registryMu.Lock()
+	registry = append(registry, c)
+	registryMu.Unlock()
+}
+
+// Counter 只增不减的计数
+type Counter struct {
+	name   string
+	help   string
+	labels []string
+	mu     sync.Mutex
+	values map[string]float64
+}
+
+// NewCounter 创建并注册计数，labels为标签名
+func NewCounter(name, help string, labels ...string) *Counter {
+	c := &Counter{name: name, help: help, labels: labels, values: map[string]float64{}}
+	register(c)
+	return c
+}
+
+// Inc 加一，labelValues和标签名一一对应
+func (c *Counter) Inc(labelValues ...string) {
+	c.Add(1, labelValues...)
+}
+
+// Add 增加v
+func (c *Counter) Add(v float64, labelValues ...string) {
+	key := seriesKey(c.labels, labelValues)
+	c.mu.Lock()
+	c.values[key] += v
+	c.mu.Unlock()
+}
+
+// Value 当前值，主要用于展示
+func (c *Counter) Value(labelValues ...string) float64 {
+	key := seriesKey(c.labels, labelValues)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.values[key]
+}
+
+func (c *Counter) write(w *bufio.Writer) {
+	writeHeader(w, c.name, c.help, "counter")
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, key := range sortedKeys(c.values) {
+		writeSample(w, c.name, c.labels, key, "", "", c.values[key])
+	}
+}
+
+// Histogram 耗时等数值的分布
+type Histogram struct {
+	name    string
+	help    string
+	labels  []string
+	buckets []float64
+	mu      sync.Mutex
+	values  map[string]*histogramValue
+}
+
+type histogramValue struct {
+	counts []uint64 // 每个分桶的数量，不累加
+	count  uint64
+	sum    float64
+}
+
+// NewHistogram 创建并注册分布，buckets为从小到大的分桶上限
+func NewHistogram(name, help string, buckets []float64, labels ...string) *Histogram {
+	h := &Histogram{name: name, help: help, labels: labels, buckets: buckets, values: map[string]*histogramValue{}}
+	register(h)
+	return h
+}
+
+// Observe 记录一个数值
+func (h *Histogram) Observe(v float64, labelValues ...string) {
+	key := seriesKey(h.labels, labelValues)
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	value, ok := h.values[key]
+	if !ok {
+		value = &histogramValue{counts: make([]uint64, len(h.buckets))}
+		h.values[key] = value
+	}
+	// 超过最大分桶的只计入+Inf
+	if idx, _ := slices.BinarySearch(h.buckets, v); idx < len(h.buckets) {
+		value.counts[idx]++
+	}
+	value.count++
+	value.sum += v
+}
+
+// Since 记录从start到现在的秒数
+func (h *Histogram) Since(start time.Time, labelValues ...string) {
+	h.Observe(time.Since(start).Seconds(), labelValues...)
+}
+
+func (h *Histogram) write(w *bufio.Writer) {
+	writeHeader(w, h.name, h.help, "histogram")
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, key := range sortedKeys(h.values) {
+		value := h.values[key]
+		var cumulative uint64
+		for i, upper := range h.buckets {
+			cumulative += value.counts[i]
+			writeSample(w, h.name+"_bucket", h.labels, key, "le", formatFloat(upper), float64(cumulative))
+		}
+		writeSample(w, h.name+"_bucket", h.labels, key, "le", "+Inf", float64(value.count))
+		writeSample(w, h.name+"_sum", h.labels, key, "", "", value.sum)
+		writeSample(w, h.name+"_count", h.labels, key, "", "", float64(value.count))
+	}
+}
+
+// WriteText 以Prometheus文本格式输出全部指标
+func WriteText(w io.Writer) error {
+	registryMu.Lock()
+	collectors := slices.Clone(registry)
+	registryMu.Unlock()
+	bw := bufio.NewWriter(w)
+	for _, c := range collectors {
+		c.write(bw)
+	}
+	return bw.Flush()
+}
+
+// Handler /metrics 接口
+func Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		_ = WriteText(w)
+	})
+}
+
+// seriesKey 标签值拼成的key，标签值个数不对时补空或截断，避免埋点写错导致panic
+func seriesKey(labels, labelValues []string) string {
+	values := make([]string, len(labels))
+	copy(values, labelValues)
+	return strings.Join(values, "\xff")
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func writeHeader(w *bufio.Writer, name, help, typ string) {
+	_, _ = fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, typ)
+}
+
+// writeSample 输出一行，extraLabel用于histogram的le
+func writeSample(w *bufio.Writer, name string, labels []string, key, extraLabel, extraValue string, v float64) {
+	_, _ = w.WriteString(name)
+	pairs := make([]string, 0, len(labels)+1)
+	if len(labels) > 0 {
+		for i, value := range strings.Split(key, "\xff") {
+			pairs = append(pairs, labels[i]+"="+strconv.Quote(value))
+		}
+	}
+	if extraLabel != "" {
+		pairs = append(pairs, extraLabel+"="+strconv.Quote(extraValue))
+	}
+	if len(pairs) > 0 {
+		_, _ = w.WriteString("{" + strings.Join(pairs, ",") + "}")
+	}
+	_, _ = w.WriteString(" " + formatFloat(v) + "\n")
+}
+
+func formatFloat(v float64) string {
+	if math.IsInf(v, 1) {
+		return "+Inf"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
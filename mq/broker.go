@@ -0,0 +1,36 @@
+package mq
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrNotConnected 还没有连上消息队列或正在重连
+var ErrNotConnected = errors.New("消息队列未连接")
+
+// Broker 消息队列，RabbitMQ和内存实现都满足该接口
+type Broker interface {
+	// Publish 发送消息并等待服务端确认
+	Publish(ctx context.Context, queue string, body []byte) error
+	// Consume 持续消费队列，断线重连后自动恢复，ctx结束后关闭返回的通道
+	Consume(ctx context.Context, queue string) (<-chan Delivery, error)
+	// Close 关闭连接
+	Close() error
+}
+
+// Delivery 收到的消息，处理完后必须调用Ack或Reject
+type Delivery struct {
+	Body   []byte
+	ack    func() error
+	reject func() error
+}
+
+// Ack 确认消息已处理
+func (d Delivery) Ack() error {
+	return d.ack()
+}
+
+// Reject 拒绝消息，消息转入死信队列，不再重新投递
+func (d Delivery) Reject() error {
+	return d.reject()
+}
@@ -0,0 +1,69 @@
+package mq
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	EnvelopeVersion = 1      // 当前消息格式版本，不兼容的修改需要加一
+	TypeChat        = "chat" // 发送到聊天室的消息
+)
+
+// Envelope 队列中的消息，payload按type解析
+type Envelope struct {
+	Version        int             `json:"version"`
+	Type           string          `json:"type"`
+	ConversationID string          `json:"conversationId"` // 聊天室id
+	Payload        json.RawMessage `json:"payload"`
+	CreatedAt      time.Time       `json:"createdAt"`
+}
+
+// ChatPayload 聊天消息内容
+type ChatPayload struct {
+	Message string `json:"message"`
+}
+
+// NewChatEnvelope 创建聊天消息
+func NewChatEnvelope(conversationID, message string) (Envelope, error) {
+	payload, err := json.Marshal(ChatPayload{Message: message})
+	if err != nil {
+		return Envelope{}, err
+	}
+	return Envelope{
+		Version:        EnvelopeVersion,
+		Type:           TypeChat,
+		ConversationID: conversationID,
+		Payload:        payload,
+		CreatedAt:      time.Now(),
+	}, nil
+}
+
+// DecodeEnvelope 解析并检查消息，版本不支持或缺少字段时返回错误
+func DecodeEnvelope(body []byte) (Envelope, error) {
+	env := Envelope{}
+	if err := json.Unmarshal(body, &env); err != nil {
+		return env, fmt.Errorf("消息格式错误: %w", err)
+	}
+	if env.Version != EnvelopeVersion {
+		return env, fmt.Errorf("不支持的消息版本:%d", env.Version)
+	}
+	if env.Type == "" || env.ConversationID == "" {
+		return env, errors.New("消息缺少type或conversationId")
+	}
+	return env, nil
+}
+
+// ChatMessage 解析聊天消息内容
+func (e Envelope) ChatMessage() (string, error) {
+	if e.Type != TypeChat {
+		return "", fmt.Errorf("不是聊天消息:%s", e.Type)
+	}
+	payload := ChatPayload{}
+	if err := json.Unmarshal(e.Payload, &payload); err != nil {
+		return "", fmt.Errorf("聊天消息格式错误: %w", err)
+	}
+	return payload.Message, nil
+}
@@ -0,0 +1,110 @@
+package mq
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// memoryQueueSize 内存队列的容量
+const memoryQueueSize = 1024
+
+// MemoryBroker 内存实现，不需要安装RabbitMQ，用于本地调试和测试，进程退出后消息丢失
+type MemoryBroker struct {
+	mu          sync.Mutex
+	queues      map[string]chan []byte
+	deadLetters map[string]string // 队列 -> 死信队列
+	closed      bool
+}
+
+// NewMemoryBroker 创建内存队列，deadLetters指定每个队列被拒绝的消息转入哪个队列
+func NewMemoryBroker(deadLetters map[string]string) *MemoryBroker {
+	return &MemoryBroker{queues: map[string]chan []byte{}, deadLetters: deadLetters}
+}
+
+func (b *MemoryBroker) queue(name string) (chan []byte, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return nil, ErrNotConnected
+	}
+	q, ok := b.queues[name]
+	if !ok {
+		q = make(chan []byte, memoryQueueSize)
+		b.queues[name] = q
+	}
+	return q, nil
+}
+
+// Publish 写入队列，队列满时返回错误
+func (b *MemoryBroker) Publish(ctx context.Context, queue string, body []byte) error {
+	q, err := b.queue(queue)
+	if err != nil {
+		return err
+	}
+	select {
+	case q <- body:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+		return errors.New("内存队列已满")
+	}
+}
+
+// Consume 消费队列，被拒绝的消息转入死信队列，ctx结束时未处理的消息退回队列
+func (b *MemoryBroker) Consume(ctx context.Context, queue string) (<-chan Delivery, error) {
+	q, err := b.queue(queue)
+	if err != nil {
+		return nil, err
+	}
+	out := make(chan Delivery)
+	go func() {
+		defer close(out)
+		for {
+			var body []byte
+			select {
+			case body = <-q:
+			case <-ctx.Done():
+				return
+			}
+			delivery := Delivery{
+				Body: body,
+				ack:  func() error { return nil },
+				reject: func() error {
+					b.mu.Lock()
+					deadLetter, ok := b.deadLetters[queue]
+					b.mu.Unlock()
+					if !ok {
+						return nil
+					}
+					return b.Publish(context.Background(), deadLetter, body)
+				},
+			}
+			select {
+			case out <- delivery:
+			case <-ctx.Done():
+				_ = b.Publish(context.Background(), queue, body)
+				return
+			}
+		}
+	}()
+	return out, nil
+}
+
+// Len 队列中等待消费的消息数
+func (b *MemoryBroker) Len(queue string) int {
+	q, err := b.queue(queue)
+	if err != nil {
+		return 0
+	}
+	return len(q)
+}
+
+// Close 关闭后写入和消费都返回ErrNotConnected
+func (b *MemoryBroker) Close() error {
+	b.mu.Lock()
+	b.closed = true
+	b.mu.Unlock()
+	return nil
+}
@@ -0,0 +1,225 @@
+package mq
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"main.go/config"
+)
+
+const (
+	testQueue      = "chat"
+	testDeadLetter = "chat.dead"
+)
+
+func testConf() config.MQConf {
+	return config.MQConf{Queue: testQueue, DeadLetterQueue: testDeadLetter, SendRetries: 2, SendIntervalMs: 1}
+}
+
+// recorder 记录发送到聊天室的消息，前failFirst次发送失败
+type recorder struct {
+	mu        sync.Mutex
+	failFirst int
+	calls     int
+	sent      []string
+}
+
+func (r *recorder) send(msg string, conversationID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls++
+	if r.calls <= r.failFirst {
+		return errors.New("发送失败")
+	}
+	r.sent = append(r.sent, conversationID+":"+msg)
+	return nil
+}
+
+func (r *recorder) snapshot() (int, []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.calls, append([]string(nil), r.sent...)
+}
+
+// listen 在后台消费，返回停止消费并等待Listen返回的函数
+func listen(t *testing.T, b Broker, c config.MQConf, send SendFunc) func() {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- Listen(ctx, b, c, send) }()
+	return func() {
+		cancel()
+		select {
+		case err := <-done:
+			if err != nil {
+				t.Errorf("Listen返回错误: %v", err)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("ctx结束后Listen没有返回")
+		}
+	}
+}
+
+// waitFor 等待条件成立，超时后测试失败
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("等待超时: %s", what)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestEnvelopeRoundTrip(t *testing.T) {
+	env, err := NewChatEnvelope("room", "你好")
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := json.Marshal(env)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := DecodeEnvelope(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg, err := decoded.ChatMessage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Version != EnvelopeVersion || decoded.Type != TypeChat || decoded.ConversationID != "room" || msg != "你好" {
+		t.Errorf("解析结果不一致: %+v %q", decoded, msg)
+	}
+
+	tests := []struct {
+		name string
+		body string
+	}{
+		{"不是json", `not json`},
+		{"版本不支持", `{"version":2,"type":"chat","conversationId":"room","payload":{"message":"x"}}`},
+		{"缺少版本", `{"type":"chat","conversationId":"room","payload":{"message":"x"}}`},
+		{"缺少聊天室", `{"version":1,"type":"chat","payload":{"message":"x"}}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := DecodeEnvelope([]byte(tt.body)); err == nil {
+				t.Error("应返回错误")
+			}
+		})
+	}
+}
+
+func TestPublishAndListen(t *testing.T) {
+	b := NewMemoryBroker(map[string]string{testQueue: testDeadLetter})
+	published, err := PublishChat(context.Background(), b, testQueue, "room", []string{"a", "b", "c"})
+	if err != nil || published != 3 {
+		t.Fatalf("PublishChat = %d, %v", published, err)
+	}
+	r := &recorder{}
+	stop := listen(t, b, testConf(), r.send)
+	waitFor(t, "发送3条消息", func() bool { _, sent := r.snapshot(); return len(sent) == 3 })
+	stop()
+	if _, sent := r.snapshot(); sent[0] != "room:a" || sent[1] != "room:b" || sent[2] != "room:c" {
+		t.Errorf("发送顺序不对: %v", sent)
+	}
+	if n := b.Len(testDeadLetter); n != 0 {
+		t.Errorf("死信队列应为空,实际%d条", n)
+	}
+}
+
+func TestMalformedGoesToDeadLetter(t *testing.T) {
+	b := NewMemoryBroker(map[string]string{testQueue: testDeadLetter})
+	bad := []byte(`{"version":99,"type":"chat","conversationId":"room"}`)
+	if err := b.Publish(context.Background(), testQueue, bad); err != nil {
+		t.Fatal(err)
+	}
+	r := &recorder{}
+	stop := listen(t, b, testConf(), r.send)
+	waitFor(t, "转入死信队列", func() bool { return b.Len(testDeadLetter) == 1 })
+	stop()
+	if calls, _ := r.snapshot(); calls != 0 {
+		t.Errorf("格式错误的消息不应发送,实际发送%d次", calls)
+	}
+}
+
+func TestSendFailureGoesToDeadLetter(t *testing.T) {
+	b := NewMemoryBroker(map[string]string{testQueue: testDeadLetter})
+	if _, err := PublishChat(context.Background(), b, testQueue, "room", []string{"a"}); err != nil {
+		t.Fatal(err)
+	}
+	c := testConf()
+	r := &recorder{failFirst: c.SendRetries + 1}
+	stop := listen(t, b, c, r.send)
+	waitFor(t, "转入死信队列", func() bool { return b.Len(testDeadLetter) == 1 })
+	stop()
+	if calls, sent := r.snapshot(); calls != c.SendRetries+1 || len(sent) != 0 {
+		t.Errorf("应尝试%d次且没有发送成功,实际%d次,发送%v", c.SendRetries+1, calls, sent)
+	}
+}
+
+func TestAckOnlyAfterSend(t *testing.T) {
+	body, err := json.Marshal(must(NewChatEnvelope("room", "a")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name       string
+		failFirst  int
+		wantAck    bool
+		wantReject bool
+	}{
+		{"第一次成功", 0, true, false},
+		{"重试后成功", 2, true, false},
+		{"重试后仍失败", 3, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &recorder{failFirst: tt.failFirst}
+			acked, rejected := false, false
+			d := Delivery{
+				Body: body,
+				ack: func() error {
+					// 确认时消息必须已经发出去
+					if _, sent := r.snapshot(); len(sent) != 1 {
+						t.Error("发送成功前就确认了消息")
+					}
+					acked = true
+					return nil
+				},
+				reject: func() error { rejected = true; return nil },
+			}
+			ok := handleChat(context.Background(), d, 2, time.Millisecond, r.send)
+			if ok != tt.wantAck || acked != tt.wantAck || rejected != tt.wantReject {
+				t.Errorf("handleChat = %v, ack = %v, reject = %v", ok, acked, rejected)
+			}
+		})
+	}
+}
+
+func TestListenStopsOnCancel(t *testing.T) {
+	b := NewMemoryBroker(nil)
+	r := &recorder{}
+	stop := listen(t, b, testConf(), r.send)
+	stop()
+	// 停止后写入的消息留在队列里，不会被发送
+	if _, err := PublishChat(context.Background(), b, testQueue, "room", []string{"a"}); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(10 * time.Millisecond)
+	if calls, _ := r.snapshot(); calls != 0 || b.Len(testQueue) != 1 {
+		t.Errorf("停止后仍在消费: 发送%d次, 队列%d条", calls, b.Len(testQueue))
+	}
+}
+
+func must(env Envelope, err error) Envelope {
+	if err != nil {
+		panic(err)
+	}
+	return env
+}
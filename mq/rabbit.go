@@ -0,0 +1,220 @@
+package mq
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"go.uber.org/zap"
+	"main.go/config"
+	"main.go/logger"
+)
+
+// RabbitBroker RabbitMQ实现，后台保持连接，断线后按退避时间重连
+type RabbitBroker struct {
+	conf   config.MQConf
+	ctx    context.Context
+	cancel func()
+	mu     sync.Mutex
+	conn   *amqp.Connection
+	pubCh  *amqp.Channel // 开启了publisher confirm的发送通道
+	ready  chan struct{} // 连上后关闭，断线后换成新的
+}
+
+// NewRabbitBroker 创建并在后台连接，连接失败不会返回错误，而是一直重连
+func NewRabbitBroker(c config.MQConf) *RabbitBroker {
+	ctx, cancel := context.WithCancel(context.Background())
+	b := &RabbitBroker{
+		conf:   c,
+		ctx:    ctx,
+		cancel: cancel,
+		ready:  make(chan struct{}),
+	}
+	go b.keepConnected()
+	return b
+}
+
+// keepConnected 保持连接，断开后重连，等待时间从reconnectMinMs开始每次翻倍
+func (b *RabbitBroker) keepConnected() {
+	minBackoff := time.Duration(b.conf.ReconnectMinMs) * time.Millisecond
+	maxBackoff := time.Duration(b.conf.ReconnectMaxMs) * time.Millisecond
+	backoff := minBackoff
+	for b.ctx.Err() == nil {
+		conn, ch, err := b.connect()
+		if amqpErr := (*amqp.Error)(nil); errors.As(err, &amqpErr) && amqpErr.Code == amqp.PreconditionFailed {
+			// 同名队列已按其他参数声明过(例如旧版本的非持久化队列)，重连不会成功，等用户删除或改名
+			logger.L().Error("消息队列已存在且参数不同,请在RabbitMQ中删除该队列或修改mq.queue/mq.deadLetterQueue",
+				zap.String("queue", b.conf.Queue), zap.String("deadLetterQueue", b.conf.DeadLetterQueue), zap.Error(err))
+			backoff = maxBackoff
+		}
+		if err != nil {
+			logger.L().Warn("连接消息队列失败", zap.Error(err), zap.Duration("retryIn", backoff))
+			select {
+			case <-b.ctx.Done():
+				return
+			case <-time.After(backoff):
+			}
+			backoff = min(backoff*2, maxBackoff)
+			reconnects.Inc()
+			continue
+		}
+		backoff = minBackoff
+		closed := conn.NotifyClose(make(chan *amqp.Error, 1))
+		b.mu.Lock()
+		b.conn, b.pubCh = conn, ch
+		close(b.ready)
+		b.mu.Unlock()
+		logger.L().Info("已连接消息队列", zap.String("queue", b.conf.Queue))
+		select {
+		case amqpErr := <-closed:
+			logger.L().Warn("消息队列连接断开", zap.Any("reason", amqpErr))
+			reconnects.Inc()
+		case <-b.ctx.Done():
+			_ = conn.Close()
+		}
+		b.mu.Lock()
+		b.conn, b.pubCh = nil, nil
+		b.ready = make(chan struct{})
+		b.mu.Unlock()
+	}
+}
+
+// connect 建立连接，打开发送通道并声明队列
+func (b *RabbitBroker) connect() (*amqp.Connection, *amqp.Channel, error) {
+	conn, err := amqp.Dial(b.conf.URL)
+	if err != nil {
+		return nil, nil, err
+	}
+	ch, err := conn.Channel()
+	if err == nil {
+		err = ch.Confirm(false)
+	}
+	if err == nil {
+		err = b.declare(ch)
+	}
+	if err != nil {
+		_ = conn.Close()
+		return nil, nil, err
+	}
+	return conn, ch, nil
+}
+
+// declare 声明持久化的消息队列和死信队列，消息被拒绝后转入死信队列
+func (b *RabbitBroker) declare(ch *amqp.Channel) error {
+	if _, err := ch.QueueDeclare(b.conf.DeadLetterQueue, true, false, false, false, nil); err != nil {
+		return err
+	}
+	_, err := ch.QueueDeclare(b.conf.Queue, true, false, false, false, amqp.Table{
+		"x-dead-letter-exchange":    "",
+		"x-dead-letter-routing-key": b.conf.DeadLetterQueue,
+	})
+	return err
+}
+
+// waitConnected 等待连接可用，ctx结束或已关闭时返回ErrNotConnected
+func (b *RabbitBroker) waitConnected(ctx context.Context) (*amqp.Connection, *amqp.Channel, error) {
+	for {
+		b.mu.Lock()
+		conn, ch, ready := b.conn, b.pubCh, b.ready
+		b.mu.Unlock()
+		if conn != nil {
+			return conn, ch, nil
+		}
+		select {
+		case <-ready:
+		case <-ctx.Done():
+			return nil, nil, ErrNotConnected
+		case <-b.ctx.Done():
+			return nil, nil, ErrNotConnected
+		}
+	}
+}
+
+// Publish 发送持久化消息，等待服务端确认，超过confirmTimeoutMs返回错误
+func (b *RabbitBroker) Publish(ctx context.Context, queue string, body []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(b.conf.ConfirmTimeoutMs)*time.Millisecond)
+	defer cancel()
+	_, ch, err := b.waitConnected(ctx)
+	if err != nil {
+		return err
+	}
+	confirm, err := ch.PublishWithDeferredConfirmWithContext(ctx, "", queue, false, false, amqp.Publishing{
+		ContentType:  "application/json",
+		DeliveryMode: amqp.Persistent,
+		Timestamp:    time.Now(),
+		Body:         body,
+	})
+	if err != nil {
+		return err
+	}
+	acked, err := confirm.WaitContext(ctx)
+	if err != nil {
+		return err
+	}
+	if !acked {
+		return errors.New("消息队列拒绝了消息")
+	}
+	return nil
+}
+
+// Consume 每次只取一条，处理完确认后再取下一条，断线后等待重连继续消费
+func (b *RabbitBroker) Consume(ctx context.Context, queue string) (<-chan Delivery, error) {
+	out := make(chan Delivery)
+	go func() {
+		defer close(out)
+		for ctx.Err() == nil {
+			conn, _, err := b.waitConnected(ctx)
+			if err != nil {
+				return
+			}
+			if err = b.consumeOnce(ctx, conn, queue, out); err != nil {
+				logger.L().Warn("消费消息失败", zap.Error(err), zap.String("queue", queue))
+				select {
+				case <-ctx.Done():
+				case <-time.After(time.Duration(b.conf.ReconnectMinMs) * time.Millisecond):
+				}
+			}
+		}
+	}()
+	return out, nil
+}
+
+// consumeOnce 在一个连接上消费，连接断开后返回
+func (b *RabbitBroker) consumeOnce(ctx context.Context, conn *amqp.Connection, queue string, out chan<- Delivery) error {
+	ch, err := conn.Channel()
+	if err != nil {
+		return err
+	}
+	defer ch.Close()
+	if err = ch.Qos(1, 0, false); err != nil {
+		return err
+	}
+	deliveries, err := ch.ConsumeWithContext(ctx, queue, "", false, false, false, false, nil)
+	if err != nil {
+		return err
+	}
+	for d := range deliveries {
+		d := d
+		delivery := Delivery{
+			Body:   d.Body,
+			ack:    func() error { return d.Ack(false) },
+			reject: func() error { return d.Nack(false, false) },
+		}
+		select {
+		case out <- delivery:
+		case <-ctx.Done():
+			// 未处理的消息退回队列
+			_ = d.Nack(false, true)
+			return nil
+		}
+	}
+	return nil
+}
+
+// Close 停止重连并关闭连接
+func (b *RabbitBroker) Close() error {
+	b.cancel()
+	return nil
+}
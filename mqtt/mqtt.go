@@ -0,0 +1,170 @@
+package mqtt
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	paho "github.com/eclipse/paho.mqtt.golang"
+	"go.uber.org/zap"
+	"main.go/config"
+	"main.go/liveclient"
+	"main.go/logger"
+	"main.go/metrics"
+	"main.go/scores"
+)
+
+// 主题，实际发布时加上配置的前缀，例如 talentscout/state
+const (
+	TopicState      = "state"             // 客户端状态
+	TopicAllies     = "lobby/allies"      // 我方评分
+	TopicEnemies    = "lobby/enemies"     // 敌方评分
+	TopicReadyCheck = "events/readycheck" // 对局确认
+	TopicLive       = "game/live"         // 游戏内记分板
+	TopicGameEvent  = "events/game"       // 游戏内事件
+)
+
+const (
+	StateOnline  = "online"  // 伯乐已启动，还没有拿到客户端状态
+	StateOffline = "offline" // 伯乐退出或掉线
+)
+
+var published = metrics.NewCounter("mqtt_messages_total", "MQTT推送的消息，result为published、error", "result")
+
+type (
+	// StatePayload 客户端状态
+	StatePayload struct {
+		State string    `json:"state"`
+		Time  time.Time `json:"time"`
+	}
+	// LobbyPayload 一方的评分，按评分从高到低排序
+	LobbyPayload struct {
+		Players []*scores.UserScore `json:"players"`
+		Time    time.Time           `json:"time"`
+	}
+	// ReadyCheckPayload 对局确认，result为accepted、skipped(未开启自动接受)、error
+	ReadyCheckPayload struct {
+		Result string    `json:"result"`
+		Time   time.Time `json:"time"`
+	}
+)
+
+// Sink 把客户端事件以保留消息发布到MQTT，订阅方连上就能拿到最新状态
+// 未开启时为nil，nil也可以直接调用各个Publish方法
+type Sink struct {
+	conf   config.MQTTConf
+	client paho.Client
+	mu     sync.Mutex
+	state  string // 最后发布的状态，重连后重新发布，覆盖服务端代发的离线遗嘱
+}
+
+// New 按配置连接MQTT服务端，最多等待timeoutMs，未开启时返回nil，连不上时在后台重试
+func New(c config.MQTTConf) *Sink {
+	if !c.Enabled {
+		return nil
+	}
+	timeout := time.Duration(c.TimeoutMs) * time.Millisecond
+	s := &Sink{conf: c, state: StateOnline}
+	offline, _ := json.Marshal(StatePayload{State: StateOffline})
+	opts := paho.NewClientOptions().
+		AddBroker(c.Broker).
+		SetClientID(c.ClientID).
+		SetUsername(c.Username).
+		SetPassword(c.Password).
+		SetConnectTimeout(timeout).
+		SetWriteTimeout(timeout).
+		SetAutoReconnect(true).
+		SetConnectRetry(true).
+		SetBinaryWill(topic(c, TopicState), offline, c.QoS, true).
+		SetOnConnectHandler(func(paho.Client) {
+			logger.L().Info("已连接MQTT", zap.String("broker", c.Broker))
+			s.mu.Lock()
+			state := s.state
+			s.mu.Unlock()
+			s.publish(TopicState, StatePayload{State: state, Time: time.Now()})
+		}).
+		SetConnectionLostHandler(func(_ paho.Client, err error) {
+			logger.L().Warn("MQTT连接断开", zap.Error(err))
+		})
+	s.client = paho.NewClient(opts)
+	// 开启了重试，Connect不会失败，超时后在后台继续连接，连上之前发布的消息会失败，连上后重新发布最后的状态
+	if !s.client.Connect().WaitTimeout(timeout) {
+		logger.L().Warn("连接MQTT超时,后台继续重试", zap.String("broker", c.Broker))
+	}
+	return s
+}
+
+// PublishState 发布客户端状态
+func (s *Sink) PublishState(state string) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	s.state = state
+	s.mu.Unlock()
+	s.publish(TopicState, StatePayload{State: state, Time: time.Now()})
+}
+
+// PublishLobby 发布一方的评分，主题为TopicAllies或TopicEnemies
+func (s *Sink) PublishLobby(topicName string, players []*scores.UserScore) {
+	s.publish(topicName, LobbyPayload{Players: players, Time: time.Now()})
+}
+
+// PublishReadyCheck 发布对局确认结果
+func (s *Sink) PublishReadyCheck(result string) {
+	s.publish(TopicReadyCheck, ReadyCheckPayload{Result: result, Time: time.Now()})
+}
+
+// PublishLive 发布游戏内记分板
+func (s *Sink) PublishLive(snapshot liveclient.Snapshot) {
+	s.publish(TopicLive, snapshot)
+}
+
+// PublishGameEvent 发布游戏内事件
+func (s *Sink) PublishGameEvent(e liveclient.Event) {
+	s.publish(TopicGameEvent, e)
+}
+
+// Close 发布离线状态后断开连接，没连上时直接断开，不等待
+func (s *Sink) Close() {
+	if s == nil {
+		return
+	}
+	if s.client.IsConnectionOpen() {
+		body, _ := json.Marshal(StatePayload{State: StateOffline, Time: time.Now()})
+		s.client.Publish(topic(s.conf, TopicState), s.conf.QoS, true, body).
+			WaitTimeout(time.Duration(s.conf.TimeoutMs) * time.Millisecond)
+	}
+	s.client.Disconnect(uint(s.conf.TimeoutMs))
+}
+
+// publish 以保留消息异步发布，失败只记录日志
+func (s *Sink) publish(topicName string, v any) {
+	if s == nil {
+		return
+	}
+	body, err := json.Marshal(v)
+	if err != nil {
+		logger.L().Error("MQTT消息序列化失败", zap.Error(err), zap.String("topic", topicName))
+		return
+	}
+	t := topic(s.conf, topicName)
+	token := s.client.Publish(t, s.conf.QoS, true, body)
+	go func() {
+		if !token.WaitTimeout(time.Duration(s.conf.TimeoutMs) * time.Millisecond) {
+			published.Inc("error")
+			logger.L().Warn("MQTT发布超时", zap.String("topic", t))
+			return
+		}
+		if err := token.Error(); err != nil {
+			published.Inc("error")
+			logger.L().Warn("MQTT发布失败", zap.Error(err), zap.String("topic", t))
+			return
+		}
+		published.Inc("published")
+	}()
+}
+
+func topic(c config.MQTTConf, name string) string {
+	return c.TopicPrefix + "/" + name
+}
@@ -0,0 +1,248 @@
+package mqtt
+
+import (
+	"encoding/json"
+	"errors"
+	"net"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	server "github.com/mochi-mqtt/server/v2"
+	"github.com/mochi-mqtt/server/v2/hooks/auth"
+	"github.com/mochi-mqtt/server/v2/listeners"
+	"github.com/mochi-mqtt/server/v2/packets"
+	"main.go/config"
+	"main.go/scores"
+)
+
+// startBroker 启动进程内的MQTT服务端，返回服务端和地址
+func startBroker(t *testing.T) (*server.Server, string) {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := l.Addr().String()
+	_ = l.Close()
+	srv := server.New(&server.Options{InlineClient: true})
+	if err = srv.AddHook(new(auth.AllowHook), nil); err != nil {
+		t.Fatal(err)
+	}
+	if err = srv.AddListener(listeners.NewTCP(listeners.Config{ID: "t", Address: addr})); err != nil {
+		t.Fatal(err)
+	}
+	if err = srv.Serve(); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = srv.Close() })
+	return srv, "tcp://" + addr
+}
+
+func testConf(broker string) config.MQTTConf {
+	return config.MQTTConf{
+		Enabled:     true,
+		Broker:      broker,
+		ClientID:    "talentscout-test",
+		TopicPrefix: "test/ts",
+		QoS:         1,
+		TimeoutMs:   2000,
+	}
+}
+
+// retained 等待主题上出现满足条件的保留消息
+func retained(t *testing.T, srv *server.Server, topicName string, ok func(packets.Packet) bool) packets.Packet {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		for _, pk := range srv.Topics.Messages(topicName) {
+			if ok(pk) {
+				return pk
+			}
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("没有收到%s的保留消息", topicName)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func anyPacket(packets.Packet) bool { return true }
+
+func TestPublishRetained(t *testing.T) {
+	srv, addr := startBroker(t)
+	c := testConf(addr)
+	sink := New(c)
+	t.Cleanup(sink.Close)
+
+	sink.PublishState("ChampSelect")
+	sink.PublishLobby(TopicAllies, []*scores.UserScore{{SummonerName: "队友", Score: 123}})
+	sink.PublishLobby(TopicEnemies, []*scores.UserScore{{SummonerName: "对手", Score: 88}})
+	sink.PublishReadyCheck("accepted")
+
+	tests := []struct {
+		topic string
+		check func(t *testing.T, body []byte)
+	}{
+		{"test/ts/state", func(t *testing.T, body []byte) {
+			p := StatePayload{}
+			if err := json.Unmarshal(body, &p); err != nil || p.State != "ChampSelect" || p.Time.IsZero() {
+				t.Errorf("state = %s, %v", body, err)
+			}
+		}},
+		{"test/ts/lobby/allies", func(t *testing.T, body []byte) {
+			p := LobbyPayload{}
+			if err := json.Unmarshal(body, &p); err != nil || len(p.Players) != 1 || p.Players[0].SummonerName != "队友" ||
+				p.Players[0].Score != 123 {
+				t.Errorf("allies = %s, %v", body, err)
+			}
+		}},
+		{"test/ts/lobby/enemies", func(t *testing.T, body []byte) {
+			p := LobbyPayload{}
+			if err := json.Unmarshal(body, &p); err != nil || len(p.Players) != 1 || p.Players[0].SummonerName != "对手" {
+				t.Errorf("enemies = %s, %v", body, err)
+			}
+		}},
+		{"test/ts/events/readycheck", func(t *testing.T, body []byte) {
+			p := ReadyCheckPayload{}
+			if err := json.Unmarshal(body, &p); err != nil || p.Result != "accepted" {
+				t.Errorf("readycheck = %s, %v", body, err)
+			}
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.topic, func(t *testing.T) {
+			pk := retained(t, srv, tt.topic, anyPacket)
+			if !pk.FixedHeader.Retain {
+				t.Error("应为保留消息")
+			}
+			if pk.FixedHeader.Qos != c.QoS {
+				t.Errorf("QoS = %d, 应为%d", pk.FixedHeader.Qos, c.QoS)
+			}
+			tt.check(t, pk.Payload)
+		})
+	}
+}
+
+func TestTopicPrefixAndQoS(t *testing.T) {
+	srv, addr := startBroker(t)
+	c := testConf(addr)
+	c.TopicPrefix, c.QoS = "home/lol", 2
+	sink := New(c)
+	t.Cleanup(sink.Close)
+
+	sink.PublishReadyCheck("skipped")
+	pk := retained(t, srv, "home/lol/events/readycheck", anyPacket)
+	if pk.FixedHeader.Qos != 2 {
+		t.Errorf("QoS = %d, 应为2", pk.FixedHeader.Qos)
+	}
+	if other := srv.Topics.Messages("test/ts/#"); len(other) != 0 {
+		t.Errorf("不应发布到默认前缀: %v", other)
+	}
+}
+
+// stateRecorder 订阅state主题，按顺序记录收到的状态
+type stateRecorder struct {
+	mu     sync.Mutex
+	states []string
+}
+
+func recordStates(t *testing.T, srv *server.Server, topicName string) *stateRecorder {
+	t.Helper()
+	r := &stateRecorder{}
+	err := srv.Subscribe(topicName, 1, func(_ *server.Client, _ packets.Subscription, pk packets.Packet) {
+		r.mu.Lock()
+		r.states = append(r.states, stateOf(pk))
+		r.mu.Unlock()
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
+// waitStates 等待按顺序收到want中的状态，中间可以夹着其他状态
+func (r *stateRecorder) waitStates(t *testing.T, want ...string) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		r.mu.Lock()
+		got := slices.Clone(r.states)
+		r.mu.Unlock()
+		i := 0
+		for _, state := range got {
+			if i < len(want) && state == want[i] {
+				i++
+			}
+		}
+		if i == len(want) {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("收到的状态%v, 应依次包含%v", got, want)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestPublishOnlineOnConnect(t *testing.T) {
+	srv, addr := startBroker(t)
+	c := testConf(addr)
+	// 上次退出时留下的离线状态
+	offline, _ := json.Marshal(StatePayload{State: StateOffline})
+	if err := srv.Publish("test/ts/state", offline, true, 0); err != nil {
+		t.Fatal(err)
+	}
+	sink := New(c)
+	t.Cleanup(sink.Close)
+	retained(t, srv, "test/ts/state", func(pk packets.Packet) bool { return stateOf(pk) == StateOnline })
+}
+
+func TestLastWillAndReconnect(t *testing.T) {
+	srv, addr := startBroker(t)
+	c := testConf(addr)
+	states := recordStates(t, srv, "test/ts/state")
+	sink := New(c)
+	t.Cleanup(sink.Close)
+	sink.PublishState("Lobby")
+	states.waitStates(t, "Lobby")
+
+	// 服务端断开连接模拟掉线，服务端代发遗嘱消息，重连后重新发布最后的状态
+	cl, ok := srv.Clients.Get(c.ClientID)
+	if !ok {
+		t.Fatal("服务端没有该客户端")
+	}
+	cl.Stop(errors.New("模拟掉线"))
+	states.waitStates(t, "Lobby", StateOffline, "Lobby")
+	pk := retained(t, srv, "test/ts/state", func(pk packets.Packet) bool { return stateOf(pk) == "Lobby" })
+	if !pk.FixedHeader.Retain || pk.FixedHeader.Qos != c.QoS {
+		t.Errorf("重连后的状态 retain = %v, QoS = %d", pk.FixedHeader.Retain, pk.FixedHeader.Qos)
+	}
+}
+
+func TestClosePublishesOffline(t *testing.T) {
+	srv, addr := startBroker(t)
+	sink := New(testConf(addr))
+	sink.PublishState("InProgress")
+	retained(t, srv, "test/ts/state", func(pk packets.Packet) bool { return stateOf(pk) == "InProgress" })
+	sink.Close()
+	retained(t, srv, "test/ts/state", func(pk packets.Packet) bool { return stateOf(pk) == StateOffline })
+}
+
+func TestNilSink(t *testing.T) {
+	if sink := New(config.MQTTConf{Enabled: false}); sink != nil {
+		t.Fatal("未开启时应返回nil")
+	}
+	var sink *Sink
+	sink.PublishState("Lobby")
+	sink.PublishLobby(TopicAllies, nil)
+	sink.PublishReadyCheck("accepted")
+	sink.Close()
+}
+
+func stateOf(pk packets.Packet) string {
+	p := StatePayload{}
+	_ = json.Unmarshal(pk.Payload, &p)
+	return p.State
+}
@@ -0,0 +1,208 @@
+package notes
+
+import (
+	"cmp"
+	"main.go/lcu/models"
+	"main.go/store"
+	"slices"
+	"sync"
+	"time"
+)
+
+const (
+	noteStoreKind      = "notes"      // 玩家备注目录
+	encounterStoreKind = "encounters" // 相遇记录目录
+	maxEncounters      = 50           // 每个玩家最多保留的相遇记录
+)
+
+// List 备注名单
+type List string
+
+const (
+	ListNone  List = ""      // 普通备注
+	ListBlack List = "black" // 黑名单
+	ListWatch List = "watch" // 关注名单
+)
+
+// Relation 和玩家的关系
+type Relation string
+
+const (
+	RelationAlly  Relation = "ally"  // 队友
+	RelationEnemy Relation = "enemy" // 对手
+)
+
+type (
+	// Note 玩家备注，以puuid为key保存在本地
+	Note struct {
+		Puuid         string    `json:"puuid"`
+		Name          string    `json:"name"`
+		List          List      `json:"list"`   // 黑名单/关注名单
+		Text          string    `json:"text"`   // 备注内容
+		Tags          []string  `json:"tags"`   // 自定义标签
+		Rating        int       `json:"rating"` // 评价 -5~5
+		LastMetGameID int64     `json:"lastMetGameID"`
+		LastMetAt     time.Time `json:"lastMetAt"`
+		UpdatedAt     time.Time `json:"updatedAt"`
+	}
+	// Encounter 一次相遇记录
+	Encounter struct {
+		GameID   int64     `json:"gameID"`
+		Time     time.Time `json:"time"`
+		Relation Relation  `json:"relation"`
+		Win      bool      `json:"win"` // 自己是否获胜
+		Champion int       `json:"champion"`
+	}
+)
+
+var mu = sync.Mutex{}
+
+// Get 查询玩家备注
+func Get(puuid string) (*Note, bool) {
+	if puuid == "" {
+		return nil, false
+	}
+	note := &Note{}
+	if err := store.Load(noteStoreKind, puuid, note); err != nil {
+		return nil, false
+	}
+	return note, true
+}
+
+// Save 保存玩家备注
+func Save(note *Note) error {
+	note.UpdatedAt = time.Now()
+	mu.Lock()
+	defer mu.Unlock()
+	return store.Save(noteStoreKind, note.Puuid, note)
+}
+
+// Delete 删除玩家备注
+func Delete(puuid string) error {
+	mu.Lock()
+	defer mu.Unlock()
+	return store.Delete(noteStoreKind, puuid)
+}
+
+// ListNotes 列出所有玩家备注
+func ListNotes() ([]*Note, error) {
+	keys, err := store.List(noteStoreKind)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*Note, 0, len(keys))
+	for _, key := range keys {
+		if note, ok := Get(key); ok {
+			list = append(list, note)
+		}
+	}
+	return list, nil
+}
+
+// Encounters 查询和某个玩家的相遇记录，从新到旧
+func Encounters(puuid string) []Encounter {
+	list := make([]Encounter, 0)
+	if puuid == "" {
+		return list
+	}
+	_ = store.Load(encounterStoreKind, puuid, &list)
+	return list
+}
+
+// RecordEncounters 从一局对局详情中记录自己和其他9名玩家的相遇，重复记录同一局会被忽略
+func RecordEncounters(selfPuuid string, gameSummary *models.GameSummary) {
+	if selfPuuid == "" {
+		return
+	}
+	puuidMapParticipantID := make(map[string]int, len(gameSummary.ParticipantIdentities))
+	for _, identity := range gameSummary.ParticipantIdentities {
+		puuidMapParticipantID[identity.Player.Puuid] = identity.ParticipantId
+	}
+	selfParticipantID, ok := puuidMapParticipantID[selfPuuid]
+	if !ok {
+		return
+	}
+	idMapParticipant := make(map[int]models.Participant, len(gameSummary.Participants))
+	for _, participant := range gameSummary.Participants {
+		idMapParticipant[participant.ParticipantId] = participant
+	}
+	self := idMapParticipant[selfParticipantID]
+
+	mu.Lock()
+	defer mu.Unlock()
+	for puuid, participantID := range puuidMapParticipantID {
+		if puuid == "" || puuid == selfPuuid {
+			continue
+		}
+		participant := idMapParticipant[participantID]
+		relation := RelationEnemy
+		if participant.TeamId == self.TeamId {
+			relation = RelationAlly
+		}
+		list := Encounters(puuid)
+		if slices.ContainsFunc(list, func(e Encounter) bool { return e.GameID == gameSummary.GameId }) {
+			continue
+		}
+		list = append(list, Encounter{
+			GameID:   gameSummary.GameId,
+			Time:     gameSummary.GameCreationDate,
+			Relation: relation,
+			Win:      self.Stats.Win,
+			Champion: participant.ChampionId,
+		})
+		slices.SortFunc(list, func(a, b Encounter) int {
+			return cmp.Compare(b.GameID, a.GameID)
+		})
+		if len(list) > maxEncounters {
+			list = list[:maxEncounters]
+		}
+		_ = store.Save(encounterStoreKind, puuid, list)
+		// 有备注的玩家同步更新上次相遇
+		note := &Note{}
+		if err := store.Load(noteStoreKind, puuid, note); err == nil && list[0].GameID != note.LastMetGameID {
+			note.LastMetGameID = list[0].GameID
+			note.LastMetAt = list[0].Time
+			_ = store.Save(noteStoreKind, puuid, note)
+		}
+	}
+}
+
+// RebuildEncounters 从全部缓存对局重新生成相遇记录
+func RebuildEncounters(selfPuuid string) (int, error) {
+	games, err := store.LoadGames()
+	if err != nil {
+		return 0, err
+	}
+	for i := range games {
+		RecordEncounters(selfPuuid, &games[i])
+	}
+	return len(games), nil
+}
+
+// Record 一名玩家的备注和相遇记录
+type Record struct {
+	Puuid      string      `json:"puuid"`
+	Note       *Note       `json:"note,omitempty"`
+	Encounters []Encounter `json:"encounters"`
+}
+
+// ListRecords 列出所有有备注或相遇记录的玩家
+func ListRecords() ([]Record, error) {
+	noteKeys, err := store.List(noteStoreKind)
+	if err != nil {
+		return nil, err
+	}
+	encounterKeys, err := store.List(encounterStoreKind)
+	if err != nil {
+		return nil, err
+	}
+	puuids := append(noteKeys, encounterKeys...)
+	slices.Sort(puuids)
+	puuids = slices.Compact(puuids)
+	records := make([]Record, 0, len(puuids))
+	for _, puuid := range puuids {
+		note, _ := Get(puuid)
+		records = append(records, Record{Puuid: puuid, Note: note, Encounters: Encounters(puuid)})
+	}
+	return records, nil
+}
@@ -0,0 +1,136 @@
+package LOLTalentScout
+
+import (
+	"fmt"
+	"main.go/notes"
+	"main.go/scores"
+	"main.go/script"
+	"strings"
+	"time"
+)
+
+const maxAlerts = 50 // 内存中保留的提醒条数
+
+// Alert 大厅中出现了有备注或者遇到过的玩家
+type Alert struct {
+	Time     time.Time      `json:"time"`
+	Puuid    string         `json:"puuid"`
+	Name     string         `json:"name"`
+	Relation notes.Relation `json:"relation"`
+	List     notes.List     `json:"list"`
+	Message  string         `json:"message"`
+}
+
+// Alerts 最近的玩家提醒，从旧到新
+func (ts *TalentScout) Alerts() []Alert {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	return append([]Alert(nil), ts.alerts...)
+}
+
+// addAlert 记录提醒并展示到命令行和通知栏
+func (ts *TalentScout) addAlert(alert Alert) {
+	ts.mu.Lock()
+	ts.alerts = append(ts.alerts, alert)
+	if len(ts.alerts) > maxAlerts {
+		ts.alerts = ts.alerts[len(ts.alerts)-maxAlerts:]
+	}
+	ts.mu.Unlock()
+	fmt.Println("!!! 玩家提醒:", alert.Message)
+	ts.ui.Notify(alert.Message)
+}
+
+// checkPlayerNotes 检查大厅中的玩家是否有备注或者曾经遇到过
+func (ts *TalentScout) checkPlayerNotes(summonerScores []*scores.UserScore, relation notes.Relation) {
+	selfPuuid := ""
+	if ts.currSummoner != nil {
+		selfPuuid = ts.currSummoner.Puuid
+	}
+	for _, scoreInfo := range summonerScores {
+		if scoreInfo.Puuid == "" || scoreInfo.Puuid == selfPuuid {
+			continue
+		}
+		note, hasNote := notes.Get(scoreInfo.Puuid)
+		encounters := notes.Encounters(scoreInfo.Puuid)
+		if !hasNote && len(encounters) == 0 {
+			continue
+		}
+		alert := Alert{
+			Time:     time.Now(),
+			Puuid:    scoreInfo.Puuid,
+			Name:     scoreInfo.SummonerName,
+			Relation: relation,
+			Message:  formatNoteAlert(scoreInfo.SummonerName, relation, note, encounters),
+		}
+		if hasNote {
+			alert.List = note.List
+		}
+		ts.addAlert(alert)
+	}
+}
+
+// runLobbyScripts 一方玩家评分完成后运行脚本，脚本给出的提醒和玩家提醒一起展示
+func (ts *TalentScout) runLobbyScripts(summonerScores []*scores.UserScore, relation notes.Relation, queueID int) {
+	for _, message := range script.OnLobbyReady(relation, queueID, summonerScores) {
+		ts.addAlert(Alert{
+			Time:     time.Now(),
+			Relation: relation,
+			Message:  message,
+		})
+	}
+}
+
+// formatNoteAlert 提醒内容，例如 [黑名单]张三(对手) 备注:挂机 评价:-3 | 曾同队2次(赢1次)
+func formatNoteAlert(name string, relation notes.Relation, note *notes.Note, encounters []notes.Encounter) string {
+	sb := strings.Builder{}
+	relationName := "队友"
+	if relation == notes.RelationEnemy {
+		relationName = "对手"
+	}
+	if note != nil {
+		switch note.List {
+		case notes.ListBlack:
+			sb.WriteString("[黑名单]")
+		case notes.ListWatch:
+			sb.WriteString("[关注]")
+		}
+	}
+	sb.WriteString(fmt.Sprintf("%s(%s)", name, relationName))
+	if note != nil {
+		if note.Text != "" {
+			sb.WriteString(" 备注:" + note.Text)
+		}
+		if len(note.Tags) > 0 {
+			sb.WriteString(" 标签:" + strings.Join(note.Tags, ","))
+		}
+		if note.Rating != 0 {
+			sb.WriteString(fmt.Sprintf(" 评价:%d", note.Rating))
+		}
+	}
+	if len(encounters) == 0 {
+		return sb.String()
+	}
+	allyCount, allyWin, enemyCount, enemyWin := 0, 0, 0, 0
+	for _, encounter := range encounters {
+		if encounter.Relation == notes.RelationAlly {
+			allyCount++
+			if encounter.Win {
+				allyWin++
+			}
+			continue
+		}
+		enemyCount++
+		if encounter.Win {
+			enemyWin++
+		}
+	}
+	sb.WriteString(" |")
+	if allyCount > 0 {
+		sb.WriteString(fmt.Sprintf(" 曾同队%d次(赢%d次)", allyCount, allyWin))
+	}
+	if enemyCount > 0 {
+		sb.WriteString(fmt.Sprintf(" 曾对位%d次(赢%d次)", enemyCount, enemyWin))
+	}
+	sb.WriteString(" 上次遇到:" + encounters[0].Time.Local().Format("2006-01-02 15:04"))
+	return sb.String()
+}
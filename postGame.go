@@ -0,0 +1,195 @@
+package LOLTalentScout
+
+import (
+	"cmp"
+	"fmt"
+	"github.com/avast/retry-go"
+	"go.uber.org/zap"
+	"main.go/config"
+	"main.go/lcu"
+	"main.go/lcu/models"
+	"main.go/logger"
+	"main.go/notes"
+	"main.go/scores"
+	"main.go/staticdata"
+	"main.go/store"
+	"main.go/utils"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	postGameStoreKind = "postgame" // 赛后报告存储目录
+	postGameMsgHeader = "LOL伯乐赛后复盘..."
+)
+
+// AnalyzePostGame 对局结束后计算全部10名玩家的得分，生成赛后报告并保存
+func (ts *TalentScout) AnalyzePostGame() {
+	conf := config.Get().PostGame
+	if !conf.Enabled || ts.currSummoner == nil {
+		return
+	}
+	start := time.Now()
+	session, err := lcu.QueryGameFlowSession()
+	if err != nil {
+		ts.log.Error("查询结算会话失败", zap.Error(err))
+		return
+	}
+	gameID := session.GameData.GameId
+	if gameID == 0 {
+		return
+	}
+	log := logger.Lobby(ts.log, gameID, string(models.GameFlowEndOfGame))
+	//刚结束的对局战绩入库有延迟，多等一会
+	var gameSummary *models.GameSummary
+	err = retry.Do(func() error {
+		var tmpErr error
+		gameSummary, tmpErr = lcu.QueryGameSummary(gameID)
+		return tmpErr
+	}, retry.Attempts(10), retry.Delay(3*time.Second), retry.DelayType(retry.FixedDelay))
+	if err != nil {
+		log.Error("获取刚结束的对局详情失败", zap.Error(err))
+		return
+	}
+	if err = store.SaveGame(gameSummary); err != nil {
+		log.Error("缓存对局详情失败", zap.Error(err))
+	}
+	notes.RecordEncounters(ts.currSummoner.Puuid, gameSummary)
+	//自定义、训练模式和人机对局不出报告
+	if !slices.Contains(scores.ScoreQueueIDs, models.GameQueueID(gameSummary.QueueId)) {
+		log.Debug("不参与评分的队列,跳过赛后报告", zap.Int("queueId", gameSummary.QueueId))
+		return
+	}
+	selfID := ts.currSummoner.SummonerId
+	report, err := scores.CalcPostGameReport(selfID, *gameSummary)
+	if err != nil {
+		log.Error("计算赛后报告失败", zap.Error(err))
+		return
+	}
+	report.SelfTrend = append(listSelfScoreTrend(selfID, gameID, conf.TrendSize-1), report.SelfScore)
+	if err = store.Save(postGameStoreKind, strconv.FormatInt(gameID, 10), report); err != nil {
+		log.Error("保存赛后报告失败", zap.Error(err))
+	}
+	fmt.Println(formatPostGameReport(report))
+	analysisDuration.Since(start, phasePostGame)
+	if !conf.SendToChat {
+		return
+	}
+	conversationID, err := lcu.GetPostGameConversationID()
+	if err != nil {
+		log.Error("获取结算房间失败", zap.Error(err))
+		return
+	}
+	sendMessages(postGameMsgHeader, postGameChatMsgList(report), conversationID)
+}
+
+// ListPostGameReports 读取保存过的赛后报告，按对局时间从旧到新排列
+func ListPostGameReports() ([]*scores.PostGameReport, error) {
+	keys, err := store.List(postGameStoreKind)
+	if err != nil {
+		return nil, err
+	}
+	reports := make([]*scores.PostGameReport, 0, len(keys))
+	for _, key := range keys {
+		report := &scores.PostGameReport{}
+		if err = store.Load(postGameStoreKind, key, report); err != nil {
+			logger.L().Warn("读取赛后报告失败", zap.Error(err), zap.String("key", key))
+			continue
+		}
+		reports = append(reports, report)
+	}
+	slices.SortFunc(reports, func(a, b *scores.PostGameReport) int {
+		return a.GameCreationDate.Compare(b.GameCreationDate)
+	})
+	return reports, nil
+}
+
+// listSelfScoreTrend 从历史赛后报告中取出自己最近limit局的得分，不包含当前对局
+func listSelfScoreTrend(selfID, currGameID int64, limit int) []float64 {
+	trend := make([]float64, 0, max(limit, 0))
+	if limit <= 0 {
+		return trend
+	}
+	reports, err := ListPostGameReports()
+	if err != nil {
+		return trend
+	}
+	for _, report := range reports {
+		if report.SelfSummonerID == selfID && report.GameID != currGameID {
+			trend = append(trend, report.SelfScore)
+		}
+	}
+	if len(trend) > limit {
+		trend = trend[len(trend)-limit:]
+	}
+	return trend
+}
+
+// formatPostGameReport 命令行展示的赛后报告
+func formatPostGameReport(report *scores.PostGameReport) string {
+	sb := strings.Builder{}
+	sb.WriteString(fmt.Sprintf("--------[赛后复盘 对局:%d 时长:%d分钟]--------\n", report.GameID,
+		report.GameDuration/60))
+	for i, player := range report.Players {
+		result := "败"
+		if player.Win {
+			result = "胜"
+		}
+		sb.WriteString(fmt.Sprintf("%2d. %s\t%s\t[%s][%s]-评分: %d KDA:%d/%d/%d 原因:%s\n", i+1,
+			utils.TruncateString(player.SummonerName, 8), staticdata.ChampionName(player.ChampionID), result, scores.Judge(player.Score), int(player.Score),
+			player.KDA[0], player.KDA[1], player.KDA[2], player.Reasons))
+	}
+	sb.WriteString(fmt.Sprintf("MVP: %s(%d)  ACE: %s(%d)  最差: %s(%d)\n",
+		report.MVP.SummonerName, int(report.MVP.Score),
+		report.ACE.SummonerName, int(report.ACE.Score),
+		report.Worst.SummonerName, int(report.Worst.Score)))
+	if len(report.SelfTrend) > 0 {
+		trend := make([]string, 0, len(report.SelfTrend))
+		for _, score := range report.SelfTrend {
+			trend = append(trend, strconv.Itoa(int(score)))
+		}
+		sb.WriteString(fmt.Sprintf("我的得分趋势: %s (%s)", strings.Join(trend, " -> "),
+			trendDirection(report.SelfTrend)))
+	}
+	return sb.String()
+}
+
+// postGameChatMsgList 发送到结算房间的精简报告
+func postGameChatMsgList(report *scores.PostGameReport) []string {
+	format := func(title string, player scores.PlayerGameScore) string {
+		return fmt.Sprintf("%s: %s\t[%s]-评分: %d KDA:%d/%d/%d", title,
+			utils.TruncateString(player.SummonerName, 5), scores.Judge(player.Score), int(player.Score),
+			player.KDA[0], player.KDA[1], player.KDA[2])
+	}
+	msgList := make([]string, 0, 3)
+	if report.MVP.SummonerID != 0 {
+		msgList = append(msgList, format("MVP", report.MVP))
+	}
+	if report.ACE.SummonerID != 0 {
+		msgList = append(msgList, format("ACE", report.ACE))
+	}
+	msgList = append(msgList, format("最差", report.Worst))
+	return msgList
+}
+
+// trendDirection 比较最近一局和之前的平均分
+func trendDirection(trend []float64) string {
+	if len(trend) < 2 {
+		return "样本不足"
+	}
+	last := trend[len(trend)-1]
+	total := 0.0
+	for _, score := range trend[:len(trend)-1] {
+		total += score
+	}
+	avg := total / float64(len(trend)-1)
+	switch cmp.Compare(int(last), int(avg)) {
+	case 1:
+		return "上升"
+	case -1:
+		return "下降"
+	}
+	return "持平"
+}
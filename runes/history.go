@@ -0,0 +1,126 @@
+package runes
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"main.go/lcu/models"
+)
+
+// minGameDurationSec 短于5分钟的对局视为重开，不统计
+const minGameDurationSec = 300
+
+type (
+	// variant 一套符文，战绩里没有属性碎片
+	variant struct {
+		primary, sub int
+		perks        [6]int
+	}
+	// variantStat 一套符文的局数和胜场
+	variantStat struct {
+		variant
+		games, wins int
+	}
+)
+
+// FromHistory 从缓存对局中找出puuid玩该英雄胜率最高的一套符文，属性碎片使用shards
+// position不为空且该位置的对局足够时只统计该位置，否则统计全部位置
+func FromHistory(games []models.GameSummary, puuid string, championID int, position string, minGames int,
+	shards []int) (Page, bool) {
+	byPosition, all := map[variant]*variantStat{}, map[variant]*variantStat{}
+	for _, game := range games {
+		if game.GameDuration < minGameDurationSec {
+			continue
+		}
+		participantID := 0
+		for _, identity := range game.ParticipantIdentities {
+			if identity.Player.Puuid == puuid {
+				participantID = identity.ParticipantId
+				break
+			}
+		}
+		idx := slices.IndexFunc(game.Participants, func(p models.Participant) bool {
+			return participantID != 0 && p.ParticipantId == participantID
+		})
+		if idx < 0 || game.Participants[idx].ChampionId != championID {
+			continue
+		}
+		p := game.Participants[idx]
+		if p.Stats.Perk0 == 0 || p.Stats.PerkPrimaryStyle == 0 {
+			continue
+		}
+		v := variant{
+			primary: p.Stats.PerkPrimaryStyle,
+			sub:     p.Stats.PerkSubStyle,
+			perks:   [6]int{p.Stats.Perk0, p.Stats.Perk1, p.Stats.Perk2, p.Stats.Perk3, p.Stats.Perk4, p.Stats.Perk5},
+		}
+		count(all, v, p.Stats.Win)
+		if position != "" && historyPosition(p.Timeline.Lane, p.Timeline.Role) == position {
+			count(byPosition, v, p.Stats.Win)
+		}
+	}
+	best, ok := bestVariant(byPosition, minGames)
+	if !ok {
+		best, ok = bestVariant(all, minGames)
+	}
+	if !ok {
+		return Page{}, false
+	}
+	return Page{
+		PrimaryStyle: best.primary,
+		SubStyle:     best.sub,
+		Perks:        append(best.perks[:], shards...),
+		Source:       fmt.Sprintf("历史%d局 胜率%.0f%%", best.games, float64(best.wins)*100/float64(best.games)),
+	}, true
+}
+
+func count(stats map[variant]*variantStat, v variant, win bool) {
+	stat, ok := stats[v]
+	if !ok {
+		stat = &variantStat{variant: v}
+		stats[v] = stat
+	}
+	stat.games++
+	if win {
+		stat.wins++
+	}
+}
+
+// bestVariant 局数不少于minGames的符文中胜率最高的，胜率按(胜场+1)/(局数+2)平滑，避免局数少的偶然全胜排在前面
+func bestVariant(stats map[variant]*variantStat, minGames int) (variantStat, bool) {
+	list := make([]variantStat, 0, len(stats))
+	for _, stat := range stats {
+		if stat.games >= minGames {
+			list = append(list, *stat)
+		}
+	}
+	if len(list) == 0 {
+		return variantStat{}, false
+	}
+	smoothed := func(s variantStat) float64 {
+		return float64(s.wins+1) / float64(s.games+2)
+	}
+	return slices.MaxFunc(list, func(a, b variantStat) int {
+		return cmp.Or(cmp.Compare(smoothed(a), smoothed(b)), cmp.Compare(a.games, b.games),
+			slices.Compare(b.perks[:], a.perks[:]))
+	}), true
+}
+
+// historyPosition 战绩中的路线和角色转成英雄选择阶段的位置
+func historyPosition(lane models.Lane, role models.ChampionRole) string {
+	switch lane {
+	case "TOP":
+		return "top"
+	case models.LaneJungle:
+		return "jungle"
+	case models.LaneMiddle, "MID":
+		return "middle"
+	case models.LaneBottom, "BOT":
+		if role == models.ChampionRoleSupport {
+			return "utility"
+		}
+		return "bottom"
+	}
+	return ""
+}
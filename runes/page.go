@@ -0,0 +1,60 @@
+package runes
+
+import (
+	"strings"
+
+	"main.go/lcu/models"
+	"main.go/staticdata"
+)
+
+// perkCount 一页符文的符文数：基石、3个主系、2个副系符文和3个属性碎片
+const perkCount = 9
+
+// Page 要导入的一页符文
+type Page struct {
+	PrimaryStyle int
+	SubStyle     int
+	Perks        []int
+	Source       string // 来源，例如 预设Yasuo.yaml、历史12局胜率67%
+}
+
+// PerkPage 转成客户端的符文页
+func (p Page) PerkPage(name string) models.PerkPage {
+	return models.PerkPage{
+		Name:            name,
+		Current:         true,
+		PrimaryStyleId:  p.PrimaryStyle,
+		SubStyleId:      p.SubStyle,
+		SelectedPerkIds: p.Perks,
+	}
+}
+
+// String 例如 征服者(精密+坚决)
+func (p Page) String() string {
+	keystone := ""
+	if len(p.Perks) > 0 {
+		keystone = staticdata.RuneName(p.Perks[0])
+	}
+	return keystone + "(" + staticdata.RuneName(p.PrimaryStyle) + "+" + staticdata.RuneName(p.SubStyle) + ")"
+}
+
+// Managed 名字带前缀的可编辑符文页，伯乐只会修改这一页
+func Managed(pages []models.PerkPage, prefix string) (models.PerkPage, bool) {
+	for _, page := range pages {
+		if page.IsEditable && strings.HasPrefix(page.Name, prefix) {
+			return page, true
+		}
+	}
+	return models.PerkPage{}, false
+}
+
+// CustomCount 已有的自定义符文页数，内置的符文页不能编辑也不占位置
+func CustomCount(pages []models.PerkPage) int {
+	count := 0
+	for _, page := range pages {
+		if page.IsEditable {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,204 @@
+package runes
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+	"main.go/config"
+	"main.go/logger"
+	"main.go/staticdata"
+	"sigs.k8s.io/yaml"
+)
+
+// Positions 英雄选择阶段分配的位置
+var Positions = []string{"top", "jungle", "middle", "bottom", "utility"}
+
+// positionNames 位置的中文名
+var positionNames = map[string]string{
+	"top":     "上单",
+	"jungle":  "打野",
+	"middle":  "中单",
+	"bottom":  "下路",
+	"utility": "辅助",
+}
+
+// presetExts 预设文件的扩展名
+var presetExts = []string{".yaml", ".yml", ".json"}
+
+type (
+	// Ref 符文id或名字，名字按静态数据查找，例如 8010、征服者、Conqueror
+	Ref int
+	// Preset 预设的一页符文
+	Preset struct {
+		Role         string `json:"role"` // top/jungle/middle/bottom/utility，为空时适用于所有位置
+		PrimaryStyle Ref    `json:"primaryStyle"`
+		SubStyle     Ref    `json:"subStyle"`
+		Perks        []Ref  `json:"perks"` // 基石、3个主系、2个副系符文和3个属性碎片
+	}
+	// presetFile 一个英雄的预设文件
+	presetFile struct {
+		Champion string   `json:"champion"` // 英雄id或名字，为空时使用文件名
+		Pages    []Preset `json:"pages"`
+	}
+	// library 英雄id -> 预设
+	library map[int][]source
+	// source 预设和所在文件
+	source struct {
+		Preset
+		file string
+	}
+)
+
+var (
+	mu      = sync.RWMutex{}
+	presets = library{}
+)
+
+// UnmarshalJSON 支持数字和名字
+func (r *Ref) UnmarshalJSON(bts []byte) error {
+	id, err := staticdata.ParseRef(staticdata.KindRune, bts)
+	*r = Ref(id)
+	return err
+}
+
+// PositionName 位置的中文名，匹配模式没有位置时为空
+func PositionName(position string) string {
+	return positionNames[position]
+}
+
+// Dir 预设目录，相对路径以当前配置文件所在目录为准
+func Dir(c config.RunesConf) string {
+	if filepath.IsAbs(c.PresetDir) {
+		return c.PresetDir
+	}
+	return filepath.Join(filepath.Dir(config.Path()), c.PresetDir)
+}
+
+// Init 加载预设目录下的全部预设，单个文件出错不影响其他文件
+func Init(c config.RunesConf) error {
+	loaded, errs, err := loadDir(c)
+	if err != nil {
+		return err
+	}
+	mu.Lock()
+	presets = loaded
+	mu.Unlock()
+	for _, loadErr := range errs {
+		logger.L().Warn("加载符文预设失败", zap.Error(loadErr))
+	}
+	return nil
+}
+
+// Validate 试加载预设目录，返回加载失败的原因，不影响当前已加载的预设
+func Validate(c config.RunesConf) error {
+	_, errs, err := loadDir(c)
+	if err != nil {
+		return err
+	}
+	return errors.Join(errs...)
+}
+
+// Find 英雄在该位置的预设，没有该位置的预设时使用不限位置的
+func Find(championID int, position string) (Page, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	list := presets[championID]
+	idx := slices.IndexFunc(list, func(s source) bool { return s.Role == position })
+	if idx < 0 {
+		idx = slices.IndexFunc(list, func(s source) bool { return s.Role == "" })
+	}
+	if idx < 0 {
+		return Page{}, false
+	}
+	s := list[idx]
+	page := Page{
+		PrimaryStyle: int(s.PrimaryStyle),
+		SubStyle:     int(s.SubStyle),
+		Perks:        make([]int, 0, len(s.Perks)),
+		Source:       "预设" + s.file,
+	}
+	for _, perk := range s.Perks {
+		page.Perks = append(page.Perks, int(perk))
+	}
+	return page, true
+}
+
+// loadDir 加载预设目录，errs是单个文件的错误，err是目录读取错误
+func loadDir(c config.RunesConf) (loaded library, errs []error, err error) {
+	loaded = library{}
+	if !c.Enabled {
+		return loaded, nil, nil
+	}
+	dir := Dir(c)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return loaded, nil, nil
+		}
+		return nil, nil, err
+	}
+	for _, entry := range entries {
+		if entry.IsDir() || !slices.Contains(presetExts, strings.ToLower(filepath.Ext(entry.Name()))) {
+			continue
+		}
+		championID, pages, loadErr := loadFile(filepath.Join(dir, entry.Name()))
+		if loadErr != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", entry.Name(), loadErr))
+			continue
+		}
+		for _, p := range pages {
+			loaded[championID] = append(loaded[championID], source{Preset: p, file: entry.Name()})
+		}
+	}
+	return loaded, errs, nil
+}
+
+// loadFile 读取并检查一个预设文件
+func loadFile(path string) (int, []Preset, error) {
+	bts, err := os.ReadFile(path)
+	if err != nil {
+		return 0, nil, err
+	}
+	f := presetFile{}
+	if err = yaml.UnmarshalStrict(bts, &f); err != nil {
+		return 0, nil, err
+	}
+	if f.Champion == "" {
+		f.Champion = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+	championID, err := strconv.Atoi(f.Champion)
+	if err != nil {
+		e, ok := staticdata.Find(staticdata.KindChampion, f.Champion)
+		if !ok {
+			return 0, nil, fmt.Errorf("未知的英雄:%s", f.Champion)
+		}
+		championID = e.ID
+	}
+	if len(f.Pages) == 0 {
+		return 0, nil, errors.New("没有符文页")
+	}
+	roles := map[string]bool{}
+	for i, p := range f.Pages {
+		if p.Role != "" && !slices.Contains(Positions, p.Role) {
+			return 0, nil, fmt.Errorf("第%d页的位置%s未知,可选:%v", i+1, p.Role, Positions)
+		}
+		if roles[p.Role] {
+			return 0, nil, fmt.Errorf("第%d页的位置%q重复", i+1, p.Role)
+		}
+		roles[p.Role] = true
+		if p.PrimaryStyle == 0 || p.SubStyle == 0 || p.PrimaryStyle == p.SubStyle {
+			return 0, nil, fmt.Errorf("第%d页的主系和副系不能为空或相同", i+1)
+		}
+		if len(p.Perks) != perkCount {
+			return 0, nil, fmt.Errorf("第%d页应有%d个符文(含属性碎片),当前为%d个", i+1, perkCount, len(p.Perks))
+		}
+	}
+	return championID, f.Pages, nil
+}
@@ -0,0 +1,51 @@
+package scores
+
+import (
+	"main.go/lcu/models"
+	"time"
+)
+
+// ScoreQueueIDs 参与评分的队列
+var ScoreQueueIDs = []models.GameQueueID{
+	models.NormalQueueID,
+	models.RankSoleQueueID,
+	models.RankFlexQueueID,
+	models.ARAMQueueID,
+}
+
+// GameScoreItem 一局得分和对局信息
+type GameScoreItem struct {
+	Score            float64
+	GameCreationDate time.Time
+	GameVersion      string // 游戏版本，例如14.3.556.5432
+}
+
+// ScoreStat 加权后的对局得分统计
+type ScoreStat struct {
+	Mean           float64 // 加权平均分
+	Games          int     // 参与评分的对局数
+	EffectiveGames float64 // 有效样本数，权重越集中越少
+}
+
+// WeightedGameScores 按加权策略统计最近多局得分
+func WeightedGameScores(items []GameScoreItem, now time.Time, weighting Weighting) ScoreStat {
+	items = weighting.Select(items)
+	if len(items) == 0 {
+		return ScoreStat{Mean: defaultScore}
+	}
+	weights := weighting.Weights(items, now)
+	totalWeight, totalSquareWeight, totalScore := 0.0, 0.0, 0.0
+	for i, item := range items {
+		totalWeight += weights[i]
+		totalSquareWeight += weights[i] * weights[i]
+		totalScore += weights[i] * item.Score
+	}
+	if totalWeight <= 0 {
+		return ScoreStat{Mean: defaultScore}
+	}
+	return ScoreStat{
+		Mean:           totalScore / totalWeight,
+		Games:          len(items),
+		EffectiveGames: totalWeight * totalWeight / totalSquareWeight,
+	}
+}
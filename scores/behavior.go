@@ -0,0 +1,183 @@
+package scores
+
+import (
+	"cmp"
+	"fmt"
+	"main.go/config"
+	"main.go/lcu/models"
+	"main.go/staticdata"
+	"slices"
+	"strings"
+)
+
+// Tag 行为标签
+type Tag struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"` // 命中的场次
+	Total int    `json:"total"` // 统计的场次
+}
+
+const (
+	TagSurrender = "投降发起者"
+	TagLeaver    = "疑似挂机"
+	TagRemake    = "重开常客"
+	TagTilt      = "连败上头"
+	TagLateNight = "深夜冲分"
+	TagTroll     = "乱出装"
+)
+
+var (
+	// noBootsChampions 买不了鞋子的英雄
+	noBootsChampions = []string{"Cassiopeia"}
+	// hybridMarksmen 常规出法术装的射手
+	hybridMarksmen = []string{"Kaisa", "Corki"}
+)
+
+// String 标签及证据，例如 投降发起者(3/20)，脚本添加的标签没有证据
+func (t Tag) String() string {
+	if t.Total == 0 {
+		return t.Name
+	}
+	return fmt.Sprintf("%s(%d/%d)", t.Name, t.Count, t.Total)
+}
+
+// CalcBehaviorTags 根据最近对局得出行为标签，games中的对局需包含短时间的重开局
+func CalcBehaviorTags(games []models.GameInfo, conf config.BehaviorConf) []Tag {
+	tags := make([]Tag, 0, 2)
+	if !conf.Enabled || len(games) == 0 {
+		return tags
+	}
+	//按时间从新到旧排列，方便统计连败
+	games = slices.Clone(games)
+	slices.SortFunc(games, func(a, b models.GameInfo) int {
+		return cmp.Compare(b.GameCreation, a.GameCreation)
+	})
+	total := len(games)
+	surrenderCount, leaverCount, remakeCount, lateNightCount, trollCount := 0, 0, 0, 0, 0
+	lossStreak, streakEnded := 0, false
+	for _, game := range games {
+		if len(game.Participants) == 0 {
+			continue
+		}
+		stats := game.Participants[0].Stats
+		isRemake := stats.GameEndedInEarlySurrender && game.GameDuration <= conf.RemakeMaxSec
+		if stats.CausedEarlySurrender && !isRemake {
+			surrenderCount++
+		}
+		if isRemake {
+			remakeCount++
+		}
+		if !isRemake && isLeaverGame(game.GameDuration, stats.GoldEarned,
+			stats.TotalMinionsKilled+stats.NeutralMinionsKilled, stats.ChampLevel, conf) {
+			leaverCount++
+		}
+		if isLateNight(game.GameCreationDate.Local().Hour(), conf) {
+			lateNightCount++
+		}
+		items := []int{stats.Item0, stats.Item1, stats.Item2, stats.Item3, stats.Item4, stats.Item5}
+		if !isRemake && isTrollBuild(game.GameMode, game.GameDuration, int(game.Participants[0].ChampionId), items, conf) {
+			trollCount++
+		}
+		//重开局不算胜负
+		if !streakEnded && !isRemake {
+			if stats.Win {
+				streakEnded = true
+			} else {
+				lossStreak++
+			}
+		}
+	}
+	appendTag := func(name string, count, minCount int) {
+		if minCount > 0 && count >= minCount {
+			tags = append(tags, Tag{Name: name, Count: count, Total: total})
+		}
+	}
+	appendTag(TagSurrender, surrenderCount, conf.SurrenderMinCount)
+	appendTag(TagLeaver, leaverCount, conf.LeaverMinCount)
+	appendTag(TagRemake, remakeCount, conf.RemakeMinCount)
+	appendTag(TagTilt, lossStreak, conf.TiltLossStreak)
+	appendTag(TagLateNight, lateNightCount, conf.LateNightMinCount)
+	appendTag(TagTroll, trollCount, conf.TrollMinCount)
+	return tags
+}
+
+// isLeaverGame 金币、补刀、等级都远低于对局时长应有的水平
+func isLeaverGame(durationSec, gold, cs, level int, conf config.BehaviorConf) bool {
+	minutes := float64(durationSec) / 60
+	if minutes < 10 {
+		return false
+	}
+	return float64(gold)/minutes < conf.LeaverGoldPerMin &&
+		float64(cs)/minutes < conf.LeaverCsPerMin &&
+		minutes/float64(max(level, 1)) > conf.LeaverMinutesPerLv
+}
+
+// isTrollBuild 按装备数据判断乱出装：经典模式的长对局出了4件以上装备还没有鞋子，或者射手出了多件纯法术装备
+// 有数据包里没有的装备时不判断有没有鞋子，避免新版本的鞋子被当成没买鞋
+func isTrollBuild(mode models.GameMode, durationSec, championID int, items []int, conf config.BehaviorConf) bool {
+	champion, ok := staticdata.Champion(championID)
+	if !ok {
+		return false
+	}
+	owned, hasBoots, allKnown, apItems := 0, false, true, 0
+	for _, id := range items {
+		if id == 0 {
+			continue
+		}
+		owned++
+		item, ok := staticdata.Lookup(staticdata.KindItem, id)
+		if !ok {
+			allKnown = false
+			continue
+		}
+		hasBoots = hasBoots || item.HasTag("Boots")
+		if item.HasTag("SpellDamage") && !item.HasTag("Damage") && !item.HasTag("AttackSpeed") &&
+			!item.HasTag("CriticalStrike") {
+			apItems++
+		}
+	}
+	if mode == models.GameModeClassic && conf.TrollMinSec > 0 && durationSec >= conf.TrollMinSec &&
+		owned >= 4 && allKnown && !hasBoots && !slices.Contains(noBootsChampions, champion.Key) {
+		return true
+	}
+	// 只统计纯射手，带法师、刺客等标签的英雄出法术装很正常
+	pureMarksman := len(champion.Tags) == 1 && champion.HasTag("Marksman")
+	return conf.TrollAPItems > 0 && pureMarksman && !slices.Contains(hybridMarksmen, champion.Key) &&
+		apItems >= conf.TrollAPItems
+}
+
+// isLateNight 开局时间是否在深夜区间，支持跨零点的区间
+func isLateNight(hour int, conf config.BehaviorConf) bool {
+	start, end := conf.LateNightStartHour, conf.LateNightEndHour
+	if start == end {
+		return false
+	}
+	if start < end {
+		return hour >= start && hour < end
+	}
+	return hour >= start || hour < end
+}
+
+// TagLabel 发送到聊天室的行为标签
+func (u *UserScore) TagLabel() string {
+	if len(u.Tags) == 0 {
+		return ""
+	}
+	names := make([]string, 0, len(u.Tags))
+	for _, tag := range u.Tags {
+		names = append(names, tag.Name)
+	}
+	return "【" + strings.Join(names, "·") + "】"
+}
+
+// TagDetail 命令行展示的行为标签及证据
+func (u *UserScore) TagDetail() string {
+	if len(u.Tags) == 0 {
+		return ""
+	}
+	details := make([]string, 0, len(u.Tags))
+	for _, tag := range u.Tags {
+		details = append(details, tag.String())
+	}
+	return "【" + strings.Join(details, ",") + "】"
+}
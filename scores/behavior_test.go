@@ -0,0 +1,47 @@
+package scores
+
+import (
+	"testing"
+
+	"main.go/config"
+	"main.go/lcu/models"
+)
+
+func TestIsTrollBuild(t *testing.T) {
+	const (
+		teemo, cassiopeia, ahri, kaisa, jinx = 17, 69, 103, 145, 222
+		unknownItem                          = 999999
+	)
+	conf := config.Default().Behavior
+	adItems := []int{3031, 3033, 3036, 3026}
+	apItems := []int{3089, 3100, 3157, 3135}
+	tests := []struct {
+		name     string
+		mode     models.GameMode
+		duration int
+		champion int
+		items    []int
+		want     bool
+	}{
+		{"正常出装", models.GameModeClassic, 1800, jinx, append([]int{3006}, adItems...), false},
+		{"长对局没有鞋子", models.GameModeClassic, 1800, jinx, adItems, true},
+		{"短对局没有鞋子", models.GameModeClassic, 900, jinx, adItems, false},
+		{"装备太少", models.GameModeClassic, 1800, jinx, adItems[:3], false},
+		{"大乱斗不看鞋子", models.GameModeARAM, 1800, jinx, adItems, false},
+		{"买不了鞋子的英雄", models.GameModeClassic, 1800, cassiopeia, apItems, false},
+		{"有未知装备", models.GameModeClassic, 1800, jinx, append([]int{unknownItem}, adItems[:3]...), false},
+		{"射手出法术装", models.GameModeARAM, 1800, jinx, append([]int{3006}, apItems[:3]...), true},
+		{"射手只出两件法术装", models.GameModeARAM, 1800, jinx, append([]int{3006}, apItems[:2]...), false},
+		{"常规出法术装的射手", models.GameModeARAM, 1800, kaisa, append([]int{3006}, apItems...), false},
+		{"带刺客标签的射手", models.GameModeARAM, 1800, teemo, append([]int{3006}, apItems...), false},
+		{"法师出法术装", models.GameModeARAM, 1800, ahri, append([]int{3006}, apItems...), false},
+		{"未知英雄", models.GameModeClassic, 1800, 0, adItems, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isTrollBuild(tt.mode, tt.duration, tt.champion, tt.items, conf); got != tt.want {
+				t.Errorf("isTrollBuild = %v, 应为%v", got, tt.want)
+			}
+		})
+	}
+}
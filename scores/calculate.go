@@ -3,7 +3,6 @@ package scores
 import (
 	"errors"
 	"main.go/lcu/models"
-	"main.go/utils"
 	"sync"
 )
 
@@ -96,13 +95,22 @@ var CalcScore = CalcScoreConf{
 
 var confMu = sync.Mutex{}
 
+// CurrCalcScoreConf 当前得分标准
+func CurrCalcScoreConf() CalcScoreConf {
+	confMu.Lock()
+	defer confMu.Unlock()
+	return CalcScore
+}
+
+// CalcUserGameScore 使用当前得分标准计算用户在某一局的得分
 func CalcUserGameScore(summonerID int64, gameSummary models.GameSummary) (*ScoreWithReason, error) {
 	//算分需要的信息ScoreConf
-	confMu.Lock()
-	calcScoreConf := CalcScore
-	confMu.Unlock()
+	return CalcUserGameScoreWithConf(summonerID, gameSummary, CurrCalcScoreConf())
+}
 
-	gameScore := NewScoreWithReason(defaultScore)
+// CalcUserGameScoreWithConf 使用指定得分标准计算用户在某一局的得分，回测不同得分标准时使用
+func CalcUserGameScoreWithConf(summonerID int64, gameSummary models.GameSummary, calcScoreConf CalcScoreConf) (
+	*ScoreWithReason, error) {
 	var userParticipantId int
 	for _, identity := range gameSummary.ParticipantIdentities {
 		if identity.Player.SummonerId == summonerID {
@@ -112,289 +120,23 @@ func CalcUserGameScore(summonerID int64, gameSummary models.GameSummary) (*Score
 	if userParticipantId == 0 {
 		return nil, errors.New("获取用户位置失败")
 	}
-	var userTeamID *models.TeamID
-	memberParticipantIDList := make([]int, 0, 4)
-	idMapParticipant := make(map[int]models.Participant, len(gameSummary.Participants))
-	for _, item := range gameSummary.Participants {
-		if item.ParticipantId == userParticipantId {
-			userTeamID = &item.TeamId
-		}
-		idMapParticipant[item.ParticipantId] = item
-	}
-	if userTeamID == nil {
-		return nil, errors.New("获取用户队伍id失败")
-	}
-	for _, item := range gameSummary.Participants {
-		//如果参与者和该用户一个阵营，就加入到memberParticipantIDList
-		if item.TeamId == *userTeamID {
-			memberParticipantIDList = append(memberParticipantIDList, item.ParticipantId)
-		}
-	}
-	//全队信息
-	totalKill := 0   // 总人头
-	totalDeath := 0  // 总死亡
-	totalAssist := 0 // 总助攻
-	totalHurt := 0   // 总伤害
-	totalMoney := 0  // 总金钱
-	for _, participant := range gameSummary.Participants {
-		if participant.TeamId != *userTeamID {
-			continue
-		}
-		totalKill += participant.Stats.Kills
-		totalDeath += participant.Stats.Deaths
-		totalAssist += participant.Stats.Assists
-		totalHurt += participant.Stats.TotalDamageDealtToChampions
-		totalMoney += participant.Stats.GoldEarned
-	}
-
-	userParticipant := idMapParticipant[userParticipantId]
-	isSupportRole := userParticipant.Timeline.Lane == models.LaneBottom &&
-		userParticipant.Timeline.Role == models.ChampionRoleSupport
-	// 一血击杀
-	if userParticipant.Stats.FirstBloodKill {
-		gameScore.Add(calcScoreConf.FirstBlood[0], ScoreOptionFirstBloodKill)
-		// 一血助攻
-	} else if userParticipant.Stats.FirstBloodAssist {
-		gameScore.Add(calcScoreConf.FirstBlood[1], ScoreOptionFirstBloodAssist)
-	}
-	// 五杀
-	if userParticipant.Stats.PentaKills > 0 {
-		gameScore.Add(calcScoreConf.PentaKills[0], ScoreOptionPentaKills)
-		// 四杀
-	} else if userParticipant.Stats.QuadraKills > 0 {
-		gameScore.Add(calcScoreConf.QuadraKills[0], ScoreOptionQuadraKills)
-		// 三杀
-	} else if userParticipant.Stats.TripleKills > 0 {
-		gameScore.Add(calcScoreConf.TripleKills[0], ScoreOptionTripleKills)
-	}
-	// 参团率
-	if totalKill > 0 {
-		joinTeamRateRank := 1
-		userJoinTeamKillRate := float64(userParticipant.Stats.Assists+userParticipant.Stats.Kills) / float64(
-			totalKill)
-		memberJoinTeamKillRates := listMemberJoinTeamKillRates(&gameSummary, totalKill, memberParticipantIDList)
-		for _, rate := range memberJoinTeamKillRates {
-			if rate > userJoinTeamKillRate {
-				joinTeamRateRank++
-			}
-		}
-		if joinTeamRateRank == 1 {
-			gameScore.Add(calcScoreConf.JoinTeamRateRank[0], ScoreOptionJoinTeamRateRank)
-		} else if joinTeamRateRank == 2 {
-			gameScore.Add(calcScoreConf.JoinTeamRateRank[1], ScoreOptionJoinTeamRateRank)
-		} else if joinTeamRateRank == 4 {
-			gameScore.Add(-calcScoreConf.JoinTeamRateRank[2], ScoreOptionJoinTeamRateRank)
-		} else if joinTeamRateRank == 5 {
-			gameScore.Add(-calcScoreConf.JoinTeamRateRank[3], ScoreOptionJoinTeamRateRank)
-		}
-	}
-	// 获取金钱
-	if totalMoney > 0 {
-		moneyRank := 1
-		userMoney := userParticipant.Stats.GoldEarned
-		memberMoneyList := listMemberMoney(&gameSummary, memberParticipantIDList)
-		for _, v := range memberMoneyList {
-			if v > userMoney {
-				moneyRank++
-			}
-		}
-		if moneyRank == 1 {
-			gameScore.Add(calcScoreConf.GoldEarnedRank[0], ScoreOptionGoldEarnedRank)
-		} else if moneyRank == 2 {
-			gameScore.Add(calcScoreConf.GoldEarnedRank[1], ScoreOptionGoldEarnedRank)
-		} else if moneyRank == 4 && !isSupportRole {
-			gameScore.Add(-calcScoreConf.GoldEarnedRank[2], ScoreOptionGoldEarnedRank)
-		} else if moneyRank == 5 && !isSupportRole {
-			gameScore.Add(-calcScoreConf.GoldEarnedRank[3], ScoreOptionGoldEarnedRank)
-		}
-	}
-	// 伤害占比
-	if totalHurt > 0 {
-		hurtRank := 1
-		userHurt := userParticipant.Stats.TotalDamageDealtToChampions
-		memberHurtList := listMemberHurt(&gameSummary, memberParticipantIDList)
-		for _, v := range memberHurtList {
-			if v > userHurt {
-				hurtRank++
-			}
-		}
-		if hurtRank == 1 {
-			gameScore.Add(calcScoreConf.HurtRank[0], ScoreOptionHurtRank)
-		} else if hurtRank == 2 {
-			gameScore.Add(calcScoreConf.HurtRank[1], ScoreOptionHurtRank)
-		}
-	}
-	// 金钱转换伤害比
-	if totalMoney > 0 && totalHurt > 0 {
-		money2hurtRateRank := 1
-		userMoney2hurtRate := float64(userParticipant.Stats.TotalDamageDealtToChampions) / float64(userParticipant.Stats.
-			GoldEarned)
-		memberMoney2hurtRateList := listMemberMoney2hurtRate(&gameSummary, memberParticipantIDList)
-		for _, v := range memberMoney2hurtRateList {
-			if v > userMoney2hurtRate {
-				money2hurtRateRank++
-			}
-		}
-		if money2hurtRateRank == 1 {
-			gameScore.Add(calcScoreConf.Money2hurtRateRank[0], ScoreOptionMoney2hurtRateRank)
-		} else if money2hurtRateRank == 2 {
-			gameScore.Add(calcScoreConf.Money2hurtRateRank[1], ScoreOptionMoney2hurtRateRank)
-		}
-	}
-	// 视野得分
-	{
-		visionScoreRank := 1
-		userVisionScore := userParticipant.Stats.VisionScore
-		memberVisionScoreList := listMemberVisionScore(&gameSummary, memberParticipantIDList)
-		for _, v := range memberVisionScoreList {
-			if v > userVisionScore {
-				visionScoreRank++
-			}
-		}
-		if visionScoreRank == 1 {
-			gameScore.Add(calcScoreConf.VisionScoreRank[0], ScoreOptionVisionScoreRank)
-		} else if visionScoreRank == 2 {
-			gameScore.Add(calcScoreConf.VisionScoreRank[1], ScoreOptionVisionScoreRank)
-		}
-	}
-	// 补兵 每分钟8个刀以上加5分 ,9+10, 10+20
-	{
-		totalMinionsKilled := userParticipant.Stats.TotalMinionsKilled
-		gameDurationMinute := gameSummary.GameDuration / 60
-		minuteMinionsKilled := totalMinionsKilled / gameDurationMinute
-		for _, minionsKilledLimit := range calcScoreConf.MinionsKilled {
-			if minuteMinionsKilled >= int(minionsKilledLimit[0]) {
-				gameScore.Add(minionsKilledLimit[1], ScoreOptionMinionsKilled)
-				break
-			}
-		}
-	}
-	// 人头占比
-	if totalKill > 0 {
-		// 人头占比>50%
-		userKillRate := float64(userParticipant.Stats.Kills) / float64(totalKill)
-	userKillRateLoop:
-		for _, killRateConfItem := range calcScoreConf.KillRate {
-			if userKillRate > killRateConfItem.Limit {
-				for _, limitConf := range killRateConfItem.ScoreConf {
-					if userParticipant.Stats.Kills > int(limitConf[0]) {
-						gameScore.Add(limitConf[1], ScoreOptionKillRate)
-						break userKillRateLoop
-					}
-				}
-			}
-		}
-	}
-	// 伤害占比
-	if totalHurt > 0 {
-		// 伤害占比>50%
-		userHurtRate := float64(userParticipant.Stats.TotalDamageDealtToChampions) / float64(totalHurt)
-	userHurtRateLoop:
-		for _, killRateConfItem := range calcScoreConf.HurtRate {
-			if userHurtRate > killRateConfItem.Limit {
-				for _, limitConf := range killRateConfItem.ScoreConf {
-					if userParticipant.Stats.Kills > int(limitConf[0]) {
-						gameScore.Add(limitConf[1], ScoreOptionHurtRate)
-						break userHurtRateLoop
-					}
-				}
-			}
-		}
-	}
-	// 助攻占比
-	if totalAssist > 0 {
-		// 助攻占比>50%
-		userAssistRate := float64(userParticipant.Stats.Assists) / float64(totalAssist)
-	userAssistRateLoop:
-		for _, killRateConfItem := range calcScoreConf.AssistRate {
-			if userAssistRate > killRateConfItem.Limit {
-				for _, limitConf := range killRateConfItem.ScoreConf {
-					if userParticipant.Stats.Kills > int(limitConf[0]) {
-						gameScore.Add(limitConf[1], ScoreOptionAssistRate)
-						break userAssistRateLoop
-					}
-				}
-			}
-		}
-	}
-	userJoinTeamKillRate := 1.0
-	if totalKill > 0 {
-		userJoinTeamKillRate = float64(userParticipant.Stats.Assists+userParticipant.Stats.Kills) / float64(
-			totalKill)
-	}
-	userDeathTimes := userParticipant.Stats.Deaths
-	if userParticipant.Stats.Deaths == 0 {
-		userDeathTimes = 1
-	}
-	adjustVal := (float64(userParticipant.Stats.Kills+userParticipant.Stats.Assists)/float64(userDeathTimes) -
-		calcScoreConf.AdjustKDA[0] +
-		float64(userParticipant.Stats.Kills-userParticipant.Stats.Deaths)/calcScoreConf.AdjustKDA[1]) * userJoinTeamKillRate
-	// log.Printf("game: %d,kda: %d/%d/%d\n", gameSummary.GameId, userParticipant.Stats.Kills,
-	// 	userParticipant.Stats.Deaths, userParticipant.Stats.Assists)
-	gameScore.Add(adjustVal, ScoreOptionKDAAdjust)
-	// kdaInfoStr := fmt.Sprintf("%d/%d/%d", userParticipant.Stats.Kills, userParticipant.Stats.Deaths,
-	// 	userParticipant.Stats.Assists)
-	// if global.IsDevMode() {
-	// 	log.Printf("对局%d得分:%.2f, kda:%s,原因:%s", gameSummary.GameId, gameScore.Value(), kdaInfoStr, gameScore.Reasons2String())
-	// }
-	return gameScore, nil
-}
-
-func listMemberVisionScore(gameSummary *models.GameSummary, memberParticipantIDList []int) []int {
-	res := make([]int, 0, 4)
-	for _, participant := range gameSummary.Participants {
-		if !utils.InArrayInt(participant.ParticipantId, memberParticipantIDList) {
-			continue
-		}
-		res = append(res, participant.Stats.VisionScore)
-	}
-	return res
-}
-
-func listMemberMoney2hurtRate(gameSummary *models.GameSummary, memberParticipantIDList []int) []float64 {
-	res := make([]float64, 0, 4)
-	for _, participant := range gameSummary.Participants {
-		if !utils.InArrayInt(participant.ParticipantId, memberParticipantIDList) {
-			continue
-		}
-		res = append(res, float64(participant.Stats.TotalDamageDealtToChampions)/float64(participant.Stats.
-			GoldEarned))
-	}
-	return res
-}
-
-func listMemberMoney(gameSummary *models.GameSummary, memberParticipantIDList []int) []int {
-	res := make([]int, 0, 4)
-	for _, participant := range gameSummary.Participants {
-		if !utils.InArrayInt(participant.ParticipantId, memberParticipantIDList) {
-			continue
-		}
-		res = append(res, participant.Stats.GoldEarned)
-	}
-	return res
+	return CalcParticipantGameScore(userParticipantId, gameSummary, calcScoreConf)
 }
 
-func listMemberJoinTeamKillRates(gameSummary *models.GameSummary, totalKill int, memberParticipantIDList []int) []float64 {
-	res := make([]float64, 0, 4)
-	for _, participant := range gameSummary.Participants {
-		if !utils.InArrayInt(participant.ParticipantId, memberParticipantIDList) {
-			continue
-		}
-		res = append(res, float64(participant.Stats.Assists+participant.Stats.Kills)/float64(
-			totalKill))
-	}
-	return res
+// CalcParticipantGameScore 根据对局中的参与者id计算得分，缓存的对局里可能没有召唤师id，得分标准先转换成评分规则再计算
+func CalcParticipantGameScore(userParticipantId int, gameSummary models.GameSummary, calcScoreConf CalcScoreConf) (
+	*ScoreWithReason, error) {
+	return CalcParticipantGameScoreWithRules(userParticipantId, gameSummary, confRulesCached(calcScoreConf))
 }
 
-func listMemberHurt(gameSummary *models.GameSummary, memberParticipantIDList []int) []int {
-	res := make([]int, 0, 4)
-	for _, participant := range gameSummary.Participants {
-		if !utils.InArrayInt(participant.ParticipantId, memberParticipantIDList) {
-			continue
-		}
-		res = append(res, participant.Stats.TotalDamageDealtToChampions)
-	}
-	return res
+// CalcParticipantGameScoreWithRules 按评分规则计算参与者的得分，同一套规则反复算分时先用ConfRules转换好
+func CalcParticipantGameScoreWithRules(userParticipantId int, gameSummary models.GameSummary, rules RuleSet) (
+	*ScoreWithReason, error) {
+	metrics, err := CalcGameMetrics(userParticipantId, gameSummary)
+	if err != nil {
+		return nil, err
+	}
+	return rules.Score(metrics), nil
 }
 
 func Judge(score float64) string {
@@ -0,0 +1,18 @@
+package scores
+
+import (
+	"os"
+
+	"sigs.k8s.io/yaml"
+)
+
+// LoadCalcScoreConf 从yaml文件读取得分标准，未填写的字段使用当前得分标准
+func LoadCalcScoreConf(path string) (CalcScoreConf, error) {
+	calcScoreConf := CurrCalcScoreConf()
+	bts, err := os.ReadFile(path)
+	if err != nil {
+		return calcScoreConf, err
+	}
+	err = yaml.Unmarshal(bts, &calcScoreConf)
+	return calcScoreConf, err
+}
@@ -2,16 +2,34 @@ package scores
 
 import (
 	"fmt"
+	"main.go/lcu/models"
 	"strings"
 )
 
 type (
 	UserScore struct {
-		SummonerID   int64    `json:"summonerID"`
-		SummonerName string   `json:"summonerName"`
-		Score        float64  `json:"scores"`
-		CurrKDA      [][3]int `json:"currKDA"`
-		IsARAM       bool     `json:"isARAM"`
+		SummonerID   int64             `json:"summonerID"`
+		Puuid        string            `json:"puuid"`
+		SummonerName string            `json:"summonerName"`
+		Score        float64           `json:"scores"`
+		ScoreLow     float64           `json:"scoreLow"`    // 评分90%置信区间下限
+		ScoreHigh    float64           `json:"scoreHigh"`   // 评分90%置信区间上限
+		SampleCount  int               `json:"sampleCount"` // 参与评分的对局数
+		Confident    bool              `json:"confident"`   // 样本是否足够
+		CurrKDA      [][3]int          `json:"currKDA"`
+		IsARAM       bool              `json:"isARAM"`
+		SoloRank     RankInfo          `json:"soloRank"`          // 单双排段位
+		FlexRank     RankInfo          `json:"flexRank"`          // 灵活组排段位
+		Smurf        SmurfResult       `json:"smurf"`             // 小号识别结果
+		Tags         []Tag             `json:"tags"`              // 行为标签
+		Scorer       string            `json:"scorer"`            // 评分模型
+		Compare      *CompareScore     `json:"compare,omitempty"` // 对比模型的评分
+		History      []models.GameInfo `json:"-"`                 // 最近对局，供脚本读取
+	}
+	// CompareScore 对比模型的评分
+	CompareScore struct {
+		Scorer string  `json:"scorer"`
+		Score  float64 `json:"score"`
 	}
 	IncScoreReason struct {
 		reason ScoreOption
@@ -0,0 +1,155 @@
+package scores
+
+import (
+	"errors"
+	"fmt"
+	"main.go/lcu/models"
+	"math"
+	"time"
+)
+
+const (
+	perMinuteScale = 20 // 平均比同局高一倍时加多少分
+	zScoreScale    = 15 // 平均高一个标准差时加多少分
+	maxRatio       = 3  // 单项比值上限，避免一项数据畸高
+)
+
+// lobbyStat 同局比较的数据项
+type lobbyStat int
+
+const (
+	lobbyStatKP     lobbyStat = iota // 每分钟击杀+助攻
+	lobbyStatDeath                   // 每分钟死亡，越少越好
+	lobbyStatDamage                  // 每分钟英雄伤害
+	lobbyStatGold                    // 每分钟金钱
+	lobbyStatCS                      // 每分钟补刀(含野怪)
+	lobbyStatVision                  // 每分钟视野得分
+	lobbyStatCount
+)
+
+// 各位置看重的数据项，辅助不看补刀和金钱，打野看重参团和视野
+var (
+	carryStatWeights   = [lobbyStatCount]float64{1, 1, 1.5, 1, 1, .5}
+	jungleStatWeights  = [lobbyStatCount]float64{1.5, 1, 1, 1, .5, 1}
+	supportStatWeights = [lobbyStatCount]float64{1.5, 1, .5, .3, 0, 1.5}
+	equalStatWeights   = [lobbyStatCount]float64{1, 1, 1, 1, 1, 1}
+)
+
+// lobbyScorer 和同局玩家比较每分钟数据的模型，不依赖得分标准，不同时长的对局可以直接比较
+type lobbyScorer struct {
+	name      string
+	weighting Weighting
+	roleAware bool // 是否按位置给数据项加权
+	calc      func(self int, stats [][lobbyStatCount]float64, weights [lobbyStatCount]float64) float64
+}
+
+func (s lobbyScorer) Name() string {
+	return s.name
+}
+
+func (s lobbyScorer) GameScore(participantID int, gameSummary models.GameSummary) (float64, error) {
+	minutes := float64(gameSummary.GameDuration) / 60
+	if minutes <= 0 {
+		return 0, errors.New("对局时长为0")
+	}
+	self := -1
+	stats := make([][lobbyStatCount]float64, 0, len(gameSummary.Participants))
+	weights := equalStatWeights
+	for _, participant := range gameSummary.Participants {
+		if participant.ParticipantId == participantID {
+			self = len(stats)
+			if s.roleAware {
+				weights = roleStatWeights(participant)
+			}
+		}
+		stat := participant.Stats
+		stats = append(stats, [lobbyStatCount]float64{
+			lobbyStatKP:     float64(stat.Kills+stat.Assists) / minutes,
+			lobbyStatDeath:  float64(stat.Deaths) / minutes,
+			lobbyStatDamage: float64(stat.TotalDamageDealtToChampions) / minutes,
+			lobbyStatGold:   float64(stat.GoldEarned) / minutes,
+			lobbyStatCS:     float64(stat.TotalMinionsKilled+stat.NeutralMinionsKilled) / minutes,
+			lobbyStatVision: float64(stat.VisionScore) / minutes,
+		})
+	}
+	if self < 0 {
+		return 0, errors.New("获取用户位置失败")
+	}
+	return s.calc(self, stats, weights), nil
+}
+
+func (s lobbyScorer) Aggregate(items []GameScoreItem, now time.Time) ScoreStat {
+	return WeightedGameScores(items, now, s.weighting)
+}
+
+func (s lobbyScorer) String() string {
+	return fmt.Sprintf("%s[%s]", s.name, s.weighting)
+}
+
+// roleStatWeights 按位置选择数据项权重
+func roleStatWeights(participant models.Participant) [lobbyStatCount]float64 {
+	switch {
+	case participant.Timeline.Lane == models.LaneBottom && participant.Timeline.Role == models.ChampionRoleSupport:
+		return supportStatWeights
+	case participant.Timeline.Lane == models.LaneJungle:
+		return jungleStatWeights
+	default:
+		return carryStatWeights
+	}
+}
+
+// perMinuteGameScore 每项数据和同局平均值的比值，死亡取反
+func perMinuteGameScore(self int, stats [][lobbyStatCount]float64, weights [lobbyStatCount]float64) float64 {
+	total, totalWeight := 0.0, 0.0
+	for item := lobbyStat(0); item < lobbyStatCount; item++ {
+		mean, _ := lobbyMeanStd(stats, item)
+		if mean <= 0 || weights[item] == 0 {
+			continue
+		}
+		ratio := min(stats[self][item]/mean, maxRatio)
+		diff := ratio - 1
+		if item == lobbyStatDeath {
+			diff = -diff
+		}
+		total += weights[item] * diff
+		totalWeight += weights[item]
+	}
+	if totalWeight == 0 {
+		return defaultScore
+	}
+	return defaultScore + perMinuteScale*total/totalWeight
+}
+
+// zScoreGameScore 每项数据在同局中的标准分，死亡取反
+func zScoreGameScore(self int, stats [][lobbyStatCount]float64, weights [lobbyStatCount]float64) float64 {
+	total, totalWeight := 0.0, 0.0
+	for item := lobbyStat(0); item < lobbyStatCount; item++ {
+		mean, std := lobbyMeanStd(stats, item)
+		if std <= 0 || weights[item] == 0 {
+			continue
+		}
+		z := (stats[self][item] - mean) / std
+		if item == lobbyStatDeath {
+			z = -z
+		}
+		total += weights[item] * z
+		totalWeight += weights[item]
+	}
+	if totalWeight == 0 {
+		return defaultScore
+	}
+	return defaultScore + zScoreScale*total/totalWeight
+}
+
+func lobbyMeanStd(stats [][lobbyStatCount]float64, item lobbyStat) (float64, float64) {
+	mean := 0.0
+	for _, stat := range stats {
+		mean += stat[item]
+	}
+	mean /= float64(len(stats))
+	variance := 0.0
+	for _, stat := range stats {
+		variance += (stat[item] - mean) * (stat[item] - mean)
+	}
+	return mean, math.Sqrt(variance / float64(len(stats)))
+}
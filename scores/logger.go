@@ -0,0 +1,10 @@
+package scores
+
+import "go.uber.org/zap"
+
+var logger = zap.NewNop()
+
+// SetLogger 设置诊断日志
+func SetLogger(l *zap.Logger) {
+	logger = l
+}
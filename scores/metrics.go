@@ -0,0 +1,185 @@
+package scores
+
+import (
+	"errors"
+	"main.go/lcu/models"
+)
+
+// 评分规则可以使用的指标
+const (
+	MetricKills            = "kills"            // 击杀
+	MetricDeaths           = "deaths"           // 死亡
+	MetricAssists          = "assists"          // 助攻
+	MetricKDA              = "kda"              // (击杀+助攻)/死亡，死亡为0时按1算
+	MetricTeamKills        = "teamKills"        // 全队击杀
+	MetricTeamAssists      = "teamAssists"      // 全队助攻
+	MetricTeamDamage       = "teamDamage"       // 全队英雄伤害
+	MetricTeamGold         = "teamGold"         // 全队金钱
+	MetricKillShare        = "killShare"        // 人头占比 0~1
+	MetricDamageShare      = "damageShare"      // 伤害占比 0~1
+	MetricAssistShare      = "assistShare"      // 助攻占比 0~1
+	MetricJoinTeamRate     = "joinTeamRate"     // 参团率 0~1
+	MetricFirstBloodKill   = "firstBloodKill"   // 一血击杀 0/1
+	MetricFirstBloodAssist = "firstBloodAssist" // 一血助攻 0/1
+	MetricPentaKills       = "pentaKills"       // 五杀次数
+	MetricQuadraKills      = "quadraKills"      // 四杀次数
+	MetricTripleKills      = "tripleKills"      // 三杀次数
+	MetricJoinTeamRateRank = "joinTeamRateRank" // 参团率队内排名 1~5
+	MetricGoldRank         = "goldRank"         // 金钱队内排名
+	MetricDamageRank       = "damageRank"       // 伤害队内排名
+	MetricGoldDamageRank   = "goldDamageRank"   // 金钱转换伤害比队内排名
+	MetricVisionRank       = "visionRank"       // 视野得分队内排名
+	MetricCsPerMin         = "csPerMin"         // 每分钟补兵，按整分钟取整
+	MetricGoldPerMin       = "goldPerMin"       // 每分钟金钱
+	MetricDamagePerMin     = "damagePerMin"     // 每分钟英雄伤害
+	MetricVisionScore      = "visionScore"      // 视野得分
+	MetricObjectiveDamage  = "objectiveDamage"  // 对战略点的伤害
+	MetricTurretDamage     = "turretDamage"     // 对防御塔的伤害
+	MetricGameMinutes      = "gameMinutes"      // 对局分钟数
+)
+
+// 评分规则可以使用的位置
+const (
+	RoleTop     = "top"
+	RoleJungle  = "jungle"
+	RoleMiddle  = "middle"
+	RoleBottom  = "bottom"
+	RoleSupport = "support"
+)
+
+var (
+	metricNames = []string{
+		MetricKills, MetricDeaths, MetricAssists, MetricKDA, MetricTeamKills, MetricTeamAssists, MetricTeamDamage,
+		MetricTeamGold, MetricKillShare, MetricDamageShare, MetricAssistShare, MetricJoinTeamRate,
+		MetricFirstBloodKill, MetricFirstBloodAssist, MetricPentaKills, MetricQuadraKills, MetricTripleKills,
+		MetricJoinTeamRateRank, MetricGoldRank, MetricDamageRank, MetricGoldDamageRank, MetricVisionRank,
+		MetricCsPerMin, MetricGoldPerMin, MetricDamagePerMin, MetricVisionScore, MetricObjectiveDamage,
+		MetricTurretDamage, MetricGameMinutes,
+	}
+	roleNames = []string{RoleTop, RoleJungle, RoleMiddle, RoleBottom, RoleSupport}
+)
+
+// GameMetrics 参与者在一局中的各项指标
+type GameMetrics struct {
+	Role   string             // 位置，识别不出时为空
+	Values map[string]float64 // 指标名 -> 值
+}
+
+// CalcGameMetrics 计算参与者在一局中的各项指标，队内排名从1开始，并列时名次相同
+func CalcGameMetrics(participantID int, gameSummary models.GameSummary) (GameMetrics, error) {
+	var user *models.Participant
+	for i := range gameSummary.Participants {
+		if gameSummary.Participants[i].ParticipantId == participantID {
+			user = &gameSummary.Participants[i]
+		}
+	}
+	if user == nil {
+		return GameMetrics{}, errors.New("获取用户队伍id失败")
+	}
+	members := make([]models.Participant, 0, 5)
+	for _, participant := range gameSummary.Participants {
+		if participant.TeamId == user.TeamId {
+			members = append(members, participant)
+		}
+	}
+	totalKill, totalAssist, totalHurt, totalMoney := 0, 0, 0, 0
+	for _, member := range members {
+		totalKill += member.Stats.Kills
+		totalAssist += member.Stats.Assists
+		totalHurt += member.Stats.TotalDamageDealtToChampions
+		totalMoney += member.Stats.GoldEarned
+	}
+	stats := user.Stats
+	deaths := max(stats.Deaths, 1)
+	minutes := float64(gameSummary.GameDuration) / 60
+	values := map[string]float64{
+		MetricKills:            float64(stats.Kills),
+		MetricDeaths:           float64(stats.Deaths),
+		MetricAssists:          float64(stats.Assists),
+		MetricKDA:              float64(stats.Kills+stats.Assists) / float64(deaths),
+		MetricTeamKills:        float64(totalKill),
+		MetricTeamAssists:      float64(totalAssist),
+		MetricTeamDamage:       float64(totalHurt),
+		MetricTeamGold:         float64(totalMoney),
+		MetricPentaKills:       float64(stats.PentaKills),
+		MetricQuadraKills:      float64(stats.QuadraKills),
+		MetricTripleKills:      float64(stats.TripleKills),
+		MetricVisionScore:      float64(stats.VisionScore),
+		MetricObjectiveDamage:  float64(stats.DamageDealtToObjectives),
+		MetricTurretDamage:     float64(stats.DamageDealtToTurrets),
+		MetricGameMinutes:      minutes,
+		MetricFirstBloodKill:   boolMetric(stats.FirstBloodKill),
+		MetricFirstBloodAssist: boolMetric(stats.FirstBloodAssist),
+	}
+	if totalKill > 0 {
+		values[MetricKillShare] = float64(stats.Kills) / float64(totalKill)
+		values[MetricJoinTeamRate] = float64(stats.Assists+stats.Kills) / float64(totalKill)
+	}
+	if totalHurt > 0 {
+		values[MetricDamageShare] = float64(stats.TotalDamageDealtToChampions) / float64(totalHurt)
+	}
+	if totalAssist > 0 {
+		values[MetricAssistShare] = float64(stats.Assists) / float64(totalAssist)
+	}
+	if minutes > 0 {
+		values[MetricGoldPerMin] = float64(stats.GoldEarned) / minutes
+		values[MetricDamagePerMin] = float64(stats.TotalDamageDealtToChampions) / minutes
+	}
+	// 补兵沿用原有算法，按整分钟取整
+	if gameDurationMinute := gameSummary.GameDuration / 60; gameDurationMinute > 0 {
+		values[MetricCsPerMin] = float64(stats.TotalMinionsKilled / gameDurationMinute)
+	}
+	values[MetricJoinTeamRateRank] = teamRank(members, *user, func(p models.Participant) float64 {
+		return float64(p.Stats.Assists+p.Stats.Kills) / float64(totalKill)
+	})
+	values[MetricGoldRank] = teamRank(members, *user, func(p models.Participant) float64 {
+		return float64(p.Stats.GoldEarned)
+	})
+	values[MetricDamageRank] = teamRank(members, *user, func(p models.Participant) float64 {
+		return float64(p.Stats.TotalDamageDealtToChampions)
+	})
+	values[MetricGoldDamageRank] = teamRank(members, *user, func(p models.Participant) float64 {
+		return float64(p.Stats.TotalDamageDealtToChampions) / float64(p.Stats.GoldEarned)
+	})
+	values[MetricVisionRank] = teamRank(members, *user, func(p models.Participant) float64 {
+		return float64(p.Stats.VisionScore)
+	})
+	return GameMetrics{Role: participantRole(*user), Values: values}, nil
+}
+
+// teamRank 队内排名，比自己高的人数+1
+func teamRank(members []models.Participant, user models.Participant, value func(models.Participant) float64) float64 {
+	rank := 1
+	userValue := value(user)
+	for _, member := range members {
+		if value(member) > userValue {
+			rank++
+		}
+	}
+	return float64(rank)
+}
+
+// participantRole 根据对局时间线识别位置
+func participantRole(participant models.Participant) string {
+	if participant.Timeline.Lane == models.LaneBottom && participant.Timeline.Role == models.ChampionRoleSupport {
+		return RoleSupport
+	}
+	switch participant.Timeline.Lane {
+	case models.LaneJungle:
+		return RoleJungle
+	case models.LaneMiddle:
+		return RoleMiddle
+	case models.LaneBottom:
+		return RoleBottom
+	case "TOP":
+		return RoleTop
+	}
+	return ""
+}
+
+func boolMetric(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,106 @@
+package scores
+
+import (
+	"cmp"
+	"errors"
+	"main.go/lcu/models"
+	"slices"
+	"strings"
+	"time"
+)
+
+type (
+	// PlayerGameScore 单个玩家在一局中的表现
+	PlayerGameScore struct {
+		SummonerID   int64         `json:"summonerID"`
+		Puuid        string        `json:"puuid"`
+		SummonerName string        `json:"summonerName"`
+		ChampionID   int           `json:"championID"`
+		TeamID       models.TeamID `json:"teamID"`
+		Win          bool          `json:"win"`
+		KDA          [3]int        `json:"kda"`
+		Score        float64       `json:"score"`
+		Reasons      string        `json:"reasons"`
+	}
+	// PostGameReport 赛后报告
+	PostGameReport struct {
+		GameID           int64             `json:"gameID"`
+		GameCreationDate time.Time         `json:"gameCreationDate"`
+		GameDuration     int               `json:"gameDuration"`
+		QueueID          int               `json:"queueID"`
+		GameMode         models.GameMode   `json:"gameMode"`
+		SelfSummonerID   int64             `json:"selfSummonerID"`
+		SelfScore        float64           `json:"selfScore"`
+		SelfTrend        []float64         `json:"selfTrend"` // 最近几局个人得分，从旧到新
+		Players          []PlayerGameScore `json:"players"`   // 按得分从高到低
+		MVP              PlayerGameScore   `json:"mvp"`       // 胜方最高分
+		ACE              PlayerGameScore   `json:"ace"`       // 败方最高分
+		Worst            PlayerGameScore   `json:"worst"`     // 全场最低分
+	}
+)
+
+// CalcPostGameReport 计算一局比赛全部玩家的得分并评出MVP、ACE和最差表现
+func CalcPostGameReport(selfSummonerID int64, gameSummary models.GameSummary) (*PostGameReport, error) {
+	idMapParticipant := make(map[int]models.Participant, len(gameSummary.Participants))
+	for _, participant := range gameSummary.Participants {
+		idMapParticipant[participant.ParticipantId] = participant
+	}
+	players := make([]PlayerGameScore, 0, len(gameSummary.ParticipantIdentities))
+	for _, identity := range gameSummary.ParticipantIdentities {
+		participant, ok := idMapParticipant[identity.ParticipantId]
+		if !ok {
+			continue
+		}
+		//按位置算分，人机和缓存对局里没有召唤师id的玩家也能算
+		gameScore, err := CalcParticipantGameScore(identity.ParticipantId, gameSummary, CurrCalcScoreConf())
+		if err != nil {
+			continue
+		}
+		name := identity.Player.GameName
+		if name == "" {
+			name = identity.Player.SummonerName
+		}
+		players = append(players, PlayerGameScore{
+			SummonerID:   identity.Player.SummonerId,
+			Puuid:        identity.Player.Puuid,
+			SummonerName: name,
+			ChampionID:   participant.ChampionId,
+			TeamID:       participant.TeamId,
+			Win:          participant.Stats.Win,
+			KDA:          [3]int{participant.Stats.Kills, participant.Stats.Deaths, participant.Stats.Assists},
+			Score:        gameScore.Value(),
+			Reasons:      strings.TrimSuffix(gameScore.Reasons2String(), ","),
+		})
+	}
+	if len(players) == 0 {
+		return nil, errors.New("对局中没有可计算的玩家")
+	}
+	slices.SortFunc(players, func(a, b PlayerGameScore) int {
+		return cmp.Compare(b.Score, a.Score)
+	})
+
+	report := &PostGameReport{
+		GameID:           gameSummary.GameId,
+		GameCreationDate: gameSummary.GameCreationDate,
+		GameDuration:     gameSummary.GameDuration,
+		QueueID:          gameSummary.QueueId,
+		GameMode:         gameSummary.GameMode,
+		SelfSummonerID:   selfSummonerID,
+		Players:          players,
+		Worst:            players[len(players)-1],
+	}
+	//列表已经按分数排序，胜方第一个就是MVP，败方第一个就是ACE
+	foundMVP, foundACE := false, false
+	for _, player := range players {
+		if player.Win && !foundMVP {
+			report.MVP, foundMVP = player, true
+		}
+		if !player.Win && !foundACE {
+			report.ACE, foundACE = player, true
+		}
+		if player.SummonerID == selfSummonerID {
+			report.SelfScore = player.Score
+		}
+	}
+	return report, nil
+}
@@ -0,0 +1,95 @@
+package scores
+
+import (
+	"fmt"
+	"main.go/lcu/models"
+)
+
+// RankInfo 单个队列的段位信息
+type RankInfo struct {
+	Tier               models.RankTier `json:"tier"`
+	Division           string          `json:"division"`
+	LeaguePoints       int             `json:"leaguePoints"`
+	Wins               int             `json:"wins"`
+	Losses             int             `json:"losses"`
+	PrevSeasonTier     models.RankTier `json:"prevSeasonTier"`
+	PrevSeasonDivision string          `json:"prevSeasonDivision"`
+}
+
+var tierNames = map[models.RankTier]string{
+	models.RankTierIron:        "黑铁",
+	models.RankTierBronze:      "青铜",
+	models.RankTierSilver:      "白银",
+	models.RankTierGold:        "黄金",
+	models.RankTierPlatinum:    "白金",
+	models.RankTierEmerald:     "翡翠",
+	models.RankTierDiamond:     "钻石",
+	models.RankTierMaster:      "大师",
+	models.RankTierGrandMaster: "宗师",
+	models.RankTierChallenger:  "王者",
+}
+
+// NewRankInfo 从排位接口数据生成段位信息
+func NewRankInfo(stats models.RankedQueueStats) RankInfo {
+	return RankInfo{
+		Tier:               stats.Tier,
+		Division:           stats.Division,
+		LeaguePoints:       stats.LeaguePoints,
+		Wins:               stats.Wins,
+		Losses:             stats.Losses,
+		PrevSeasonTier:     stats.PreviousSeasonHighestTier,
+		PrevSeasonDivision: stats.PreviousSeasonHighestDivision,
+	}
+}
+
+// IsRanked 是否已定级
+func (r RankInfo) IsRanked() bool {
+	_, ok := tierNames[r.Tier]
+	return ok
+}
+
+// Games 本赛季排位场次
+func (r RankInfo) Games() int {
+	return r.Wins + r.Losses
+}
+
+// String 段位展示，例如 钻石II 56LP
+func (r RankInfo) String() string {
+	if !r.IsRanked() {
+		return "未定级"
+	}
+	return fmt.Sprintf("%s %dLP", tierString(r.Tier, r.Division), r.LeaguePoints)
+}
+
+// PrevSeasonString 上赛季最高段位
+func (r RankInfo) PrevSeasonString() string {
+	if _, ok := tierNames[r.PrevSeasonTier]; !ok {
+		return "无"
+	}
+	return tierString(r.PrevSeasonTier, r.PrevSeasonDivision)
+}
+
+// Detail 命令行展示的完整段位信息
+func (r RankInfo) Detail() string {
+	if !r.IsRanked() {
+		return fmt.Sprintf("未定级(上赛季:%s)", r.PrevSeasonString())
+	}
+	return fmt.Sprintf("%s(%d胜%d负,上赛季:%s)", r.String(), r.Wins, r.Losses, r.PrevSeasonString())
+}
+
+// RankLabel 展示在马匹后面的段位，优先展示单双排
+func (u *UserScore) RankLabel() string {
+	if u.SoloRank.IsRanked() || !u.FlexRank.IsRanked() {
+		return "单双:" + u.SoloRank.String()
+	}
+	return "灵活:" + u.FlexRank.String()
+}
+
+func tierString(tier models.RankTier, division string) string {
+	name := tierNames[tier]
+	//大师以上没有小段
+	if division == "" || division == "NA" {
+		return name
+	}
+	return name + division
+}
@@ -0,0 +1,305 @@
+package scores
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"go.uber.org/zap"
+	"main.go/lcu/models"
+	"math"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	"sigs.k8s.io/yaml"
+)
+
+// ScorerRules 按规则文件加减分
+const ScorerRules = "rules"
+
+//go:embed rules/default.yaml
+var defaultRulesYAML []byte
+
+type (
+	// RuleCondition 规则条件，指标和阈值比较
+	RuleCondition struct {
+		Metric string  `json:"metric"` // 指标名，见metrics.go
+		Op     string  `json:"op"`     // 比较方式 > >= < <= == !=
+		Value  float64 `json:"value"`  // 阈值
+	}
+	// Rule 一条评分规则，条件全部满足时加分
+	Rule struct {
+		Name         string          `json:"name"`                   // 得分原因
+		Group        string          `json:"group,omitempty"`        // 同一组只取第一条命中的规则
+		When         []RuleCondition `json:"when"`                   // 条件
+		Score        float64         `json:"score"`                  // 加分，扣分为负数
+		Roles        []string        `json:"roles,omitempty"`        // 只对这些位置生效，为空时不限
+		ExcludeRoles []string        `json:"excludeRoles,omitempty"` // 对这些位置不生效
+		Disabled     bool            `json:"disabled,omitempty"`     // 是否禁用
+	}
+	// RuleSet 评分规则集合，按顺序计算
+	RuleSet struct {
+		BaseScore float64    `json:"baseScore"` // 基础分
+		Rules     []Rule     `json:"rules"`
+		AdjustKDA [2]float64 `json:"adjustKDA"` // kda微调 (kda-a+(击杀-死亡)/b)*参团率，都为0时不微调
+	}
+)
+
+var ruleOps = map[string]func(a, b float64) bool{
+	">":  func(a, b float64) bool { return a > b },
+	">=": func(a, b float64) bool { return a >= b },
+	"<":  func(a, b float64) bool { return a < b },
+	"<=": func(a, b float64) bool { return a <= b },
+	"==": func(a, b float64) bool { return a == b },
+	"!=": func(a, b float64) bool { return a != b },
+}
+
+// DefaultRuleSet 内置评分规则，和原有得分标准一致
+func DefaultRuleSet() RuleSet {
+	rs, err := ParseRuleSet(defaultRulesYAML)
+	if err != nil {
+		panic(fmt.Sprintf("内置评分规则错误:%v", err))
+	}
+	return rs
+}
+
+// ParseRuleSet 解析并校验yaml规则
+func ParseRuleSet(bts []byte) (RuleSet, error) {
+	rs := RuleSet{BaseScore: defaultScore}
+	if err := yaml.Unmarshal(bts, &rs); err != nil {
+		return rs, err
+	}
+	return rs, rs.Validate()
+}
+
+// LoadRuleSet 从yaml文件读取评分规则
+func LoadRuleSet(path string) (RuleSet, error) {
+	bts, err := os.ReadFile(path)
+	if err != nil {
+		return RuleSet{}, err
+	}
+	rs, err := ParseRuleSet(bts)
+	if err != nil {
+		return rs, fmt.Errorf("%s: %w", path, err)
+	}
+	return rs, nil
+}
+
+// Validate 检查指标名、比较方式和位置是否正确
+func (rs RuleSet) Validate() error {
+	for i, rule := range rs.Rules {
+		if len(rule.When) == 0 {
+			return fmt.Errorf("第%d条规则%s没有条件", i+1, rule.Name)
+		}
+		for _, cond := range rule.When {
+			if !slices.Contains(metricNames, cond.Metric) {
+				return fmt.Errorf("第%d条规则%s的指标%s不存在,可选:%v", i+1, rule.Name, cond.Metric, metricNames)
+			}
+			if _, ok := ruleOps[cond.Op]; !ok {
+				return fmt.Errorf("第%d条规则%s的比较方式%s不支持", i+1, rule.Name, cond.Op)
+			}
+		}
+		for _, role := range append(slices.Clone(rule.Roles), rule.ExcludeRoles...) {
+			if !slices.Contains(roleNames, role) {
+				return fmt.Errorf("第%d条规则%s的位置%s不存在,可选:%v", i+1, rule.Name, role, roleNames)
+			}
+		}
+	}
+	return nil
+}
+
+// match 规则是否对该位置生效且条件全部满足
+func (r Rule) match(metrics GameMetrics) bool {
+	if len(r.Roles) > 0 && !slices.Contains(r.Roles, metrics.Role) {
+		return false
+	}
+	if slices.Contains(r.ExcludeRoles, metrics.Role) {
+		return false
+	}
+	for _, cond := range r.When {
+		op, ok := ruleOps[cond.Op]
+		if !ok || !op(metrics.Values[cond.Metric], cond.Value) {
+			return false
+		}
+	}
+	return true
+}
+
+// Score 按规则计算单局得分
+func (rs RuleSet) Score(metrics GameMetrics) *ScoreWithReason {
+	gameScore := NewScoreWithReason(rs.BaseScore)
+	matchedGroups := make(map[string]bool)
+	for _, rule := range rs.Rules {
+		if rule.Disabled || (rule.Group != "" && matchedGroups[rule.Group]) || !rule.match(metrics) {
+			continue
+		}
+		if rule.Group != "" {
+			matchedGroups[rule.Group] = true
+		}
+		gameScore.Add(rule.Score, ScoreOption(rule.Name))
+	}
+	if rs.AdjustKDA != [2]float64{} {
+		values := metrics.Values
+		joinTeamRate := 1.0
+		if values[MetricTeamKills] > 0 {
+			joinTeamRate = values[MetricJoinTeamRate]
+		}
+		adjustVal := (values[MetricKDA] - rs.AdjustKDA[0] +
+			(values[MetricKills]-values[MetricDeaths])/rs.AdjustKDA[1]) * joinTeamRate
+		gameScore.Add(adjustVal, ScoreOptionKDAAdjust)
+	}
+	return gameScore
+}
+
+// ConfRules 把得分标准转换成等价的评分规则
+func ConfRules(conf CalcScoreConf) RuleSet {
+	gt := func(metric string, value float64) RuleCondition {
+		return RuleCondition{Metric: metric, Op: ">", Value: value}
+	}
+	eq := func(metric string, value float64) RuleCondition {
+		return RuleCondition{Metric: metric, Op: "==", Value: value}
+	}
+	rankRules := func(name ScoreOption, metric, guard string, scores []float64, excludeRoles []string) []Rule {
+		ranks := []float64{1, 2, 4, 5}
+		rules := make([]Rule, 0, len(scores))
+		for i, score := range scores {
+			rule := Rule{Name: string(name), When: []RuleCondition{eq(metric, ranks[i])}, Score: score}
+			if guard != "" {
+				rule.When = append([]RuleCondition{gt(guard, 0)}, rule.When...)
+			}
+			// 第4、5名扣分
+			if ranks[i] > 3 {
+				rule.Score = -score
+				rule.ExcludeRoles = excludeRoles
+			}
+			rules = append(rules, rule)
+		}
+		return rules
+	}
+	rs := RuleSet{BaseScore: defaultScore, AdjustKDA: conf.AdjustKDA}
+	rs.Rules = append(rs.Rules,
+		Rule{Name: string(ScoreOptionFirstBloodKill), Group: "firstBlood",
+			When: []RuleCondition{gt(MetricFirstBloodKill, 0)}, Score: conf.FirstBlood[0]},
+		Rule{Name: string(ScoreOptionFirstBloodAssist), Group: "firstBlood",
+			When: []RuleCondition{gt(MetricFirstBloodAssist, 0)}, Score: conf.FirstBlood[1]},
+		Rule{Name: string(ScoreOptionPentaKills), Group: "multiKill",
+			When: []RuleCondition{gt(MetricPentaKills, 0)}, Score: conf.PentaKills[0]},
+		Rule{Name: string(ScoreOptionQuadraKills), Group: "multiKill",
+			When: []RuleCondition{gt(MetricQuadraKills, 0)}, Score: conf.QuadraKills[0]},
+		Rule{Name: string(ScoreOptionTripleKills), Group: "multiKill",
+			When: []RuleCondition{gt(MetricTripleKills, 0)}, Score: conf.TripleKills[0]},
+	)
+	rs.Rules = append(rs.Rules, rankRules(ScoreOptionJoinTeamRateRank, MetricJoinTeamRateRank, MetricTeamKills,
+		conf.JoinTeamRateRank[:], nil)...)
+	rs.Rules = append(rs.Rules, rankRules(ScoreOptionGoldEarnedRank, MetricGoldRank, MetricTeamGold,
+		conf.GoldEarnedRank[:], []string{RoleSupport})...)
+	rs.Rules = append(rs.Rules, rankRules(ScoreOptionHurtRank, MetricDamageRank, MetricTeamDamage,
+		conf.HurtRank[:], nil)...)
+	money2hurt := rankRules(ScoreOptionMoney2hurtRateRank, MetricGoldDamageRank, MetricTeamGold,
+		conf.Money2hurtRateRank[:], nil)
+	for i := range money2hurt {
+		money2hurt[i].When = append([]RuleCondition{gt(MetricTeamDamage, 0)}, money2hurt[i].When...)
+	}
+	rs.Rules = append(rs.Rules, money2hurt...)
+	rs.Rules = append(rs.Rules, rankRules(ScoreOptionVisionScoreRank, MetricVisionRank, "",
+		conf.VisionScoreRank[:], nil)...)
+	for _, item := range conf.MinionsKilled {
+		rs.Rules = append(rs.Rules, Rule{Name: string(ScoreOptionMinionsKilled), Group: "minionsKilled",
+			When:  []RuleCondition{{Metric: MetricCsPerMin, Op: ">=", Value: math.Trunc(item[0])}},
+			Score: item[1]})
+	}
+	rateRules := func(name ScoreOption, group, metric string, items []RateItemConf) {
+		for _, item := range items {
+			// 原有得分标准的占比阈值按百分数填写，和0~1的占比比较永远不会命中，转换后保持禁用
+			limit, disabled := item.Limit, false
+			if limit > 1 {
+				limit, disabled = limit/100, true
+			}
+			for _, scoreConf := range item.ScoreConf {
+				rs.Rules = append(rs.Rules, Rule{Name: string(name), Group: group,
+					When:     []RuleCondition{gt(metric, limit), gt(MetricKills, math.Trunc(scoreConf[0]))},
+					Score:    scoreConf[1],
+					Disabled: disabled})
+			}
+		}
+	}
+	rateRules(ScoreOptionKillRate, "killShare", MetricKillShare, conf.KillRate)
+	rateRules(ScoreOptionHurtRate, "damageShare", MetricDamageShare, conf.HurtRate)
+	rateRules(ScoreOptionAssistRate, "assistShare", MetricAssistShare, conf.AssistRate)
+	return rs
+}
+
+var (
+	rulesMu        = sync.Mutex{}
+	rulesCache     = map[string]RuleSet{}
+	confRulesCache = map[string]RuleSet{} // 得分标准的json -> 转换后的评分规则
+)
+
+// confRulesCached 得分标准转换成的评分规则，同一个得分标准只转换一次
+func confRulesCached(conf CalcScoreConf) RuleSet {
+	key, err := json.Marshal(conf)
+	if err != nil {
+		return ConfRules(conf)
+	}
+	rulesMu.Lock()
+	defer rulesMu.Unlock()
+	rs, ok := confRulesCache[string(key)]
+	if !ok {
+		rs = ConfRules(conf)
+		confRulesCache[string(key)] = rs
+	}
+	return rs
+}
+
+// loadRuleSetCached 读取规则文件，路径为空时使用内置规则，读取失败时打印原因并使用内置规则
+func loadRuleSetCached(path string) RuleSet {
+	rulesMu.Lock()
+	defer rulesMu.Unlock()
+	if rs, ok := rulesCache[path]; ok {
+		return rs
+	}
+	rs := DefaultRuleSet()
+	if path != "" {
+		fileRules, err := LoadRuleSet(path)
+		if err != nil {
+			logger.Warn("读取评分规则失败,使用内置规则", zap.Error(err), zap.String("path", path))
+		} else {
+			rs = fileRules
+		}
+	}
+	rulesCache[path] = rs
+	return rs
+}
+
+// rulesScorer 规则模型，按规则文件加减分
+type rulesScorer struct {
+	rules     RuleSet
+	source    string
+	weighting Weighting
+}
+
+// NewRulesScorer 使用指定规则的规则模型，source用于说明规则来源
+func NewRulesScorer(rules RuleSet, source string, weighting Weighting) Scorer {
+	return rulesScorer{rules: rules, source: source, weighting: weighting}
+}
+
+func (s rulesScorer) Name() string {
+	return ScorerRules
+}
+
+func (s rulesScorer) GameScore(participantID int, gameSummary models.GameSummary) (float64, error) {
+	gameScore, err := CalcParticipantGameScoreWithRules(participantID, gameSummary, s.rules)
+	if err != nil {
+		return 0, err
+	}
+	return gameScore.Value(), nil
+}
+
+func (s rulesScorer) Aggregate(items []GameScoreItem, now time.Time) ScoreStat {
+	return WeightedGameScores(items, now, s.weighting)
+}
+
+func (s rulesScorer) String() string {
+	return fmt.Sprintf("%s(%s,%d条)[%s]", s.Name(), s.source, len(s.rules.Rules), s.weighting)
+}
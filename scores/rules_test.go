@@ -0,0 +1,285 @@
+package scores
+
+import (
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"main.go/lcu/models"
+)
+
+// legacyGameScore 改成评分规则之前的算分逻辑，只用于检查ConfRules和内置规则文件的结果是否一致
+func legacyGameScore(userParticipantId int, gameSummary models.GameSummary, conf CalcScoreConf) *ScoreWithReason {
+	gameScore := NewScoreWithReason(defaultScore)
+	var user models.Participant
+	for _, item := range gameSummary.Participants {
+		if item.ParticipantId == userParticipantId {
+			user = item
+		}
+	}
+	members := make([]models.Participant, 0, 5)
+	totalKill, totalAssist, totalHurt, totalMoney := 0, 0, 0, 0
+	for _, p := range gameSummary.Participants {
+		if p.TeamId != user.TeamId {
+			continue
+		}
+		members = append(members, p)
+		totalKill += p.Stats.Kills
+		totalAssist += p.Stats.Assists
+		totalHurt += p.Stats.TotalDamageDealtToChampions
+		totalMoney += p.Stats.GoldEarned
+	}
+	rank := func(value func(models.Participant) float64) int {
+		r := 1
+		for _, m := range members {
+			if value(m) > value(user) {
+				r++
+			}
+		}
+		return r
+	}
+	addRank := func(r int, scores []float64, reason ScoreOption, penalize bool) {
+		switch {
+		case r == 1:
+			gameScore.Add(scores[0], reason)
+		case r == 2:
+			gameScore.Add(scores[1], reason)
+		case r == 4 && penalize && len(scores) > 2:
+			gameScore.Add(-scores[2], reason)
+		case r == 5 && penalize && len(scores) > 3:
+			gameScore.Add(-scores[3], reason)
+		}
+	}
+	stats := user.Stats
+	isSupportRole := user.Timeline.Lane == models.LaneBottom && user.Timeline.Role == models.ChampionRoleSupport
+	if stats.FirstBloodKill {
+		gameScore.Add(conf.FirstBlood[0], ScoreOptionFirstBloodKill)
+	} else if stats.FirstBloodAssist {
+		gameScore.Add(conf.FirstBlood[1], ScoreOptionFirstBloodAssist)
+	}
+	if stats.PentaKills > 0 {
+		gameScore.Add(conf.PentaKills[0], ScoreOptionPentaKills)
+	} else if stats.QuadraKills > 0 {
+		gameScore.Add(conf.QuadraKills[0], ScoreOptionQuadraKills)
+	} else if stats.TripleKills > 0 {
+		gameScore.Add(conf.TripleKills[0], ScoreOptionTripleKills)
+	}
+	if totalKill > 0 {
+		addRank(rank(func(p models.Participant) float64 {
+			return float64(p.Stats.Assists+p.Stats.Kills) / float64(totalKill)
+		}), conf.JoinTeamRateRank[:], ScoreOptionJoinTeamRateRank, true)
+	}
+	if totalMoney > 0 {
+		addRank(rank(func(p models.Participant) float64 { return float64(p.Stats.GoldEarned) }),
+			conf.GoldEarnedRank[:], ScoreOptionGoldEarnedRank, !isSupportRole)
+	}
+	if totalHurt > 0 {
+		addRank(rank(func(p models.Participant) float64 { return float64(p.Stats.TotalDamageDealtToChampions) }),
+			conf.HurtRank[:], ScoreOptionHurtRank, true)
+	}
+	if totalMoney > 0 && totalHurt > 0 {
+		addRank(rank(func(p models.Participant) float64 {
+			return float64(p.Stats.TotalDamageDealtToChampions) / float64(p.Stats.GoldEarned)
+		}), conf.Money2hurtRateRank[:], ScoreOptionMoney2hurtRateRank, true)
+	}
+	addRank(rank(func(p models.Participant) float64 { return float64(p.Stats.VisionScore) }),
+		conf.VisionScoreRank[:], ScoreOptionVisionScoreRank, true)
+	minuteMinionsKilled := stats.TotalMinionsKilled / (gameSummary.GameDuration / 60)
+	for _, limit := range conf.MinionsKilled {
+		if minuteMinionsKilled >= int(limit[0]) {
+			gameScore.Add(limit[1], ScoreOptionMinionsKilled)
+			break
+		}
+	}
+	rate := func(total, value int, items []RateItemConf, reason ScoreOption) {
+		if total <= 0 {
+			return
+		}
+		userRate := float64(value) / float64(total)
+		for _, item := range items {
+			if userRate > item.Limit {
+				for _, limitConf := range item.ScoreConf {
+					if stats.Kills > int(limitConf[0]) {
+						gameScore.Add(limitConf[1], reason)
+						return
+					}
+				}
+			}
+		}
+	}
+	rate(totalKill, stats.Kills, conf.KillRate, ScoreOptionKillRate)
+	rate(totalHurt, stats.TotalDamageDealtToChampions, conf.HurtRate, ScoreOptionHurtRate)
+	rate(totalAssist, stats.Assists, conf.AssistRate, ScoreOptionAssistRate)
+	joinTeamRate := 1.0
+	if totalKill > 0 {
+		joinTeamRate = float64(stats.Assists+stats.Kills) / float64(totalKill)
+	}
+	adjustVal := (float64(stats.Kills+stats.Assists)/float64(max(stats.Deaths, 1)) - conf.AdjustKDA[0] +
+		float64(stats.Kills-stats.Deaths)/conf.AdjustKDA[1]) * joinTeamRate
+	gameScore.Add(adjustVal, ScoreOptionKDAAdjust)
+	return gameScore
+}
+
+// newTestGame 10人的对局，前5人为蓝色方，参与者id从1开始，edit修改默认数据
+func newTestGame(duration int, edit func(ps []models.Participant)) models.GameSummary {
+	lanes := []models.Lane{"TOP", models.LaneJungle, models.LaneMiddle, models.LaneBottom, models.LaneBottom}
+	ps := make([]models.Participant, 10)
+	for i := range ps {
+		p := &ps[i]
+		p.ParticipantId = i + 1
+		p.TeamId = models.TeamIDBlue
+		if i >= 5 {
+			p.TeamId = models.TeamIDRed
+		}
+		p.Timeline.Lane = lanes[i%5]
+		if i%5 == 4 {
+			p.Timeline.Role = models.ChampionRoleSupport
+		}
+		p.Stats.Kills, p.Stats.Deaths, p.Stats.Assists = 3+i%5, 4, 6
+		p.Stats.GoldEarned = 9000 + 500*(i%5)
+		p.Stats.TotalDamageDealtToChampions = 15000 + 1000*(i%5)
+		p.Stats.VisionScore = 20 + 3*(i%5)
+		p.Stats.TotalMinionsKilled = 150 + 20*(i%5)
+	}
+	if edit != nil {
+		edit(ps)
+	}
+	return models.GameSummary{GameDuration: duration, Participants: ps}
+}
+
+// randomTestGame 随机生成对局数据，用于覆盖各种排名和阈值组合
+func randomTestGame(r *rand.Rand) models.GameSummary {
+	return newTestGame(600+r.Intn(2400), func(ps []models.Participant) {
+		for i := range ps {
+			s := &ps[i].Stats
+			s.Kills, s.Deaths, s.Assists = r.Intn(25), r.Intn(15), r.Intn(30)
+			s.GoldEarned = 3000 + r.Intn(18000)
+			s.TotalDamageDealtToChampions = r.Intn(60000)
+			s.VisionScore = r.Intn(4) * 10 // 制造并列
+			s.TotalMinionsKilled = r.Intn(400)
+			s.FirstBloodKill = r.Intn(10) == 0
+			s.FirstBloodAssist = r.Intn(5) == 0
+			s.PentaKills = boolInt(r.Intn(30) == 0)
+			s.QuadraKills = boolInt(r.Intn(15) == 0)
+			s.TripleKills = boolInt(r.Intn(6) == 0)
+		}
+	})
+}
+
+func boolInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// percentLimits 把占比阈值改成0~1，让占比加分项可以命中
+func percentLimits(conf CalcScoreConf) CalcScoreConf {
+	convert := func(items []RateItemConf) []RateItemConf {
+		res := slices.Clone(items)
+		for i := range res {
+			res[i].Limit /= 100
+		}
+		return res
+	}
+	conf.KillRate, conf.HurtRate, conf.AssistRate = convert(conf.KillRate), convert(conf.HurtRate),
+		convert(conf.AssistRate)
+	return conf
+}
+
+func assertSameScore(t *testing.T, want, got *ScoreWithReason) {
+	t.Helper()
+	if math.Abs(want.Value()-got.Value()) > 1e-9 || want.Reasons2String() != got.Reasons2String() {
+		t.Errorf("得分不一致:\n原有逻辑 %.4f %s\n评分规则 %.4f %s", want.Value(), want.Reasons2String(),
+			got.Value(), got.Reasons2String())
+	}
+}
+
+func TestConfRulesParity(t *testing.T) {
+	tests := []struct {
+		name string
+		game models.GameSummary
+	}{
+		{"默认数据", newTestGame(1800, nil)},
+		{"一血击杀和五杀", newTestGame(1800, func(ps []models.Participant) {
+			ps[2].Stats.FirstBloodKill, ps[2].Stats.FirstBloodAssist = true, true
+			ps[2].Stats.PentaKills, ps[2].Stats.QuadraKills, ps[2].Stats.TripleKills = 1, 1, 2
+		})},
+		{"一血助攻和三杀", newTestGame(1800, func(ps []models.Participant) {
+			ps[0].Stats.FirstBloodAssist = true
+			ps[0].Stats.TripleKills = 1
+		})},
+		{"全队零击杀", newTestGame(900, func(ps []models.Participant) {
+			for i := 0; i < 5; i++ {
+				ps[i].Stats.Kills, ps[i].Stats.Assists = 0, 0
+			}
+		})},
+		{"全队零伤害", newTestGame(900, func(ps []models.Participant) {
+			for i := 0; i < 5; i++ {
+				ps[i].Stats.TotalDamageDealtToChampions = 0
+			}
+		})},
+		{"辅助打钱垫底", newTestGame(1800, func(ps []models.Participant) {
+			ps[4].Stats.GoldEarned = 1000
+		})},
+		{"下路打钱垫底", newTestGame(1800, func(ps []models.Participant) {
+			ps[3].Stats.GoldEarned = 1000
+		})},
+		{"数据全部并列", newTestGame(1800, func(ps []models.Participant) {
+			for i := range ps {
+				ps[i].Stats = ps[0].Stats
+			}
+		})},
+		{"零死亡超神", newTestGame(1500, func(ps []models.Participant) {
+			ps[1].Stats.Kills, ps[1].Stats.Deaths, ps[1].Stats.Assists = 21, 0, 9
+			ps[1].Stats.TotalDamageDealtToChampions = 60000
+			ps[1].Stats.TotalMinionsKilled = 310
+		})},
+		{"刚好一分钟", newTestGame(60, nil)},
+	}
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		tests = append(tests, struct {
+			name string
+			game models.GameSummary
+		}{"随机对局", randomTestGame(r)})
+	}
+	confs := []struct {
+		name  string
+		conf  CalcScoreConf
+		rules RuleSet
+	}{
+		{"默认得分标准", CalcScore, ConfRules(CalcScore)},
+		{"内置规则文件", CalcScore, DefaultRuleSet()},
+		{"占比阈值0~1", percentLimits(CalcScore), ConfRules(percentLimits(CalcScore))},
+	}
+	for _, c := range confs {
+		t.Run(c.name, func(t *testing.T) {
+			for _, tt := range tests {
+				t.Run(tt.name, func(t *testing.T) {
+					for participantID := 1; participantID <= 10; participantID++ {
+						got, err := CalcParticipantGameScoreWithRules(participantID, tt.game, c.rules)
+						if err != nil {
+							t.Fatal(err)
+						}
+						assertSameScore(t, legacyGameScore(participantID, tt.game, c.conf), got)
+					}
+				})
+			}
+		})
+	}
+}
+
+func TestCalcParticipantGameScoreUsesConf(t *testing.T) {
+	game := newTestGame(1800, func(ps []models.Participant) { ps[0].Stats.FirstBloodKill = true })
+	conf := CalcScore
+	conf.FirstBlood = [2]float64{33, 0}
+	for _, c := range []CalcScoreConf{CalcScore, conf, CalcScore} {
+		got, err := CalcParticipantGameScore(1, game, c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertSameScore(t, legacyGameScore(1, game, c), got)
+	}
+}
@@ -0,0 +1,144 @@
+package scores
+
+import (
+	"errors"
+	"fmt"
+	"go.uber.org/zap"
+	"main.go/config"
+	"main.go/lcu/models"
+	"slices"
+	"sync"
+	"time"
+)
+
+const (
+	ScorerClassic   = "classic"   // 按得分标准加减分，原有算法
+	ScorerPerMinute = "perminute" // 每分钟数据和同局平均值的比值
+	ScorerZScore    = "zscore"    // 每分钟数据在同局中的标准分，按位置加权
+)
+
+// Scorer 评分模型：先给每一局打分，再把多局得分合成评分
+type Scorer interface {
+	// Name 模型名称
+	Name() string
+	// GameScore 参与者在某一局的得分
+	GameScore(participantID int, gameSummary models.GameSummary) (float64, error)
+	// Aggregate 合成最近多局得分
+	Aggregate(items []GameScoreItem, now time.Time) ScoreStat
+	// String 模型和参数说明
+	String() string
+}
+
+// ScorerFactory 根据加权策略创建评分模型
+type ScorerFactory func(weighting Weighting) Scorer
+
+var (
+	scorerMu       = sync.RWMutex{}
+	scorerRegistry = map[string]ScorerFactory{
+		ScorerClassic: func(weighting Weighting) Scorer {
+			return NewClassicScorer(CurrCalcScoreConf(), weighting)
+		},
+		ScorerPerMinute: func(weighting Weighting) Scorer {
+			return lobbyScorer{name: ScorerPerMinute, weighting: weighting, calc: perMinuteGameScore}
+		},
+		ScorerZScore: func(weighting Weighting) Scorer {
+			return lobbyScorer{name: ScorerZScore, weighting: weighting, roleAware: true, calc: zScoreGameScore}
+		},
+		ScorerRules: func(weighting Weighting) Scorer {
+			path := config.Get().Scoring.RulesFile
+			source := path
+			if source == "" {
+				source = "内置规则"
+			}
+			return NewRulesScorer(loadRuleSetCached(path), source, weighting)
+		},
+	}
+)
+
+// RegisterScorer 注册评分模型，同名会覆盖
+func RegisterScorer(name string, factory ScorerFactory) {
+	scorerMu.Lock()
+	scorerRegistry[name] = factory
+	scorerMu.Unlock()
+}
+
+// ScorerNames 已注册的评分模型
+func ScorerNames() []string {
+	scorerMu.RLock()
+	defer scorerMu.RUnlock()
+	names := make([]string, 0, len(scorerRegistry))
+	for name := range scorerRegistry {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// NewScorer 按名称创建评分模型
+func NewScorer(name string, weighting Weighting) (Scorer, error) {
+	scorerMu.RLock()
+	factory, ok := scorerRegistry[name]
+	scorerMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("未知的评分模型:%s,可选:%v", name, ScorerNames())
+	}
+	return factory(weighting), nil
+}
+
+// NewQueueScorer 按队列选择评分模型，未配置或配置错误时使用经典模型
+func NewQueueScorer(conf config.ScoringConf, queueID int, weighting Weighting) Scorer {
+	name := conf.Default
+	if queueName, ok := conf.Queues[queueID]; ok {
+		name = queueName
+	}
+	scorer, err := NewScorer(name, weighting)
+	if err != nil {
+		logger.Warn("评分模型配置错误,使用"+ScorerClassic, zap.Error(err), zap.Int("queueID", queueID))
+		return NewClassicScorer(CurrCalcScoreConf(), weighting)
+	}
+	return scorer
+}
+
+// FindParticipantID 根据召唤师id或puuid找到参与者id
+func FindParticipantID(gameSummary models.GameSummary, summonerID int64, puuid string) (int, error) {
+	for _, identity := range gameSummary.ParticipantIdentities {
+		if summonerID != 0 && identity.Player.SummonerId == summonerID {
+			return identity.ParticipantId, nil
+		}
+		if puuid != "" && identity.Player.Puuid == puuid {
+			return identity.ParticipantId, nil
+		}
+	}
+	return 0, errors.New("获取用户位置失败")
+}
+
+// classicScorer 经典模型，按得分标准加减分
+type classicScorer struct {
+	rules     RuleSet // 得分标准转换成的评分规则
+	weighting Weighting
+}
+
+// NewClassicScorer 使用指定得分标准的经典模型
+func NewClassicScorer(conf CalcScoreConf, weighting Weighting) Scorer {
+	return classicScorer{rules: ConfRules(conf), weighting: weighting}
+}
+
+func (s classicScorer) Name() string {
+	return ScorerClassic
+}
+
+func (s classicScorer) GameScore(participantID int, gameSummary models.GameSummary) (float64, error) {
+	gameScore, err := CalcParticipantGameScoreWithRules(participantID, gameSummary, s.rules)
+	if err != nil {
+		return 0, err
+	}
+	return gameScore.Value(), nil
+}
+
+func (s classicScorer) Aggregate(items []GameScoreItem, now time.Time) ScoreStat {
+	return WeightedGameScores(items, now, s.weighting)
+}
+
+func (s classicScorer) String() string {
+	return fmt.Sprintf("%s[%s]", s.Name(), s.weighting)
+}
@@ -0,0 +1,83 @@
+package scores
+
+import (
+	"fmt"
+	"main.go/config"
+	"main.go/lcu/models"
+	"math"
+	"slices"
+)
+
+const (
+	PriorGlobal = "global" // 所有玩家使用同一个先验评分
+	PriorRank   = "rank"   // 按段位给先验评分
+	// 90%置信区间
+	credibleZ = 1.645
+	// 没有对局时的标签
+	horseUnknown = "未知"
+)
+
+// PriorScore 先验评分，按段位时以黄金为基准，每差一个大段位修正rankStep分
+func PriorScore(solo, flex RankInfo, conf config.ShrinkageConf) float64 {
+	if conf.Prior != PriorRank {
+		return conf.PriorScore
+	}
+	rank := solo
+	if !rank.IsRanked() {
+		rank = flex
+	}
+	idx := slices.Index(tierOrder, rank.Tier)
+	if idx < 0 {
+		return conf.PriorScore
+	}
+	return conf.PriorScore + float64(idx-slices.Index(tierOrder, models.RankTierGold))*conf.RankPriorStep
+}
+
+// SetScore 把加权平均分向先验评分收缩后写入评分，样本越少越接近先验，同时给出90%置信区间
+func (u *UserScore) SetScore(stat ScoreStat, prior float64, conf config.ShrinkageConf) {
+	u.SampleCount = stat.Games
+	u.Score, u.ScoreLow, u.ScoreHigh = Shrink(stat, prior, conf)
+	u.Confident = stat.Games > 0 && (conf.MaxInterval <= 0 || u.ScoreHigh-u.ScoreLow <= conf.MaxInterval)
+}
+
+// Shrink 收缩后的评分和置信区间，未开启时直接使用加权平均分
+func Shrink(stat ScoreStat, prior float64, conf config.ShrinkageConf) (score, low, high float64) {
+	if stat.Games == 0 {
+		return prior, prior, prior
+	}
+	if !conf.Enabled {
+		return stat.Mean, stat.Mean, stat.Mean
+	}
+	n := stat.EffectiveGames
+	k := max(conf.PriorGames, 0)
+	score = (n*stat.Mean + k*prior) / (n + k)
+	std := conf.GameScoreStd / math.Sqrt(n+k)
+	return score, score - credibleZ*std, score + credibleZ*std
+}
+
+// HorseLabel 马匹评级，没有对局时为未知，置信区间太宽时加上问号
+func (u *UserScore) HorseLabel() string {
+	if u.SampleCount == 0 {
+		return horseUnknown
+	}
+	if !u.Confident {
+		return Judge(u.Score) + "?"
+	}
+	return Judge(u.Score)
+}
+
+// ConfidenceDetail 评分置信区间，例如 112(98~126,15局)
+func (u *UserScore) ConfidenceDetail() string {
+	if u.SampleCount == 0 {
+		return "无有效对局"
+	}
+	return fmt.Sprintf("%d~%d,%d局", int(u.ScoreLow), int(u.ScoreHigh), u.SampleCount)
+}
+
+// CompareDetail 对比模型的评分，例如 [zscore:112]
+func (u *UserScore) CompareDetail() string {
+	if u.Compare == nil {
+		return ""
+	}
+	return fmt.Sprintf("[%s:%d]", u.Compare.Scorer, int(u.Compare.Score))
+}
@@ -0,0 +1,162 @@
+package scores
+
+import (
+	"errors"
+	"fmt"
+	"main.go/config"
+	"main.go/lcu/models"
+	"math"
+	"strings"
+)
+
+type (
+	// GameStat 用户在单局中的基础数据
+	GameStat struct {
+		Kills       int     `json:"kills"`
+		Deaths      int     `json:"deaths"`
+		Assists     int     `json:"assists"`
+		DamageShare float64 `json:"damageShare"` // 伤害占全队比例 0~1
+		Win         bool    `json:"win"`
+		Score       float64 `json:"score"` // 该局得分
+	}
+	// SmurfInput 小号识别需要的数据
+	SmurfInput struct {
+		SummonerLevel int
+		RankedGames   int  // 本赛季排位场次
+		RankedKnown   bool // 是否查到了排位信息，查询失败时不使用排位场次信号
+		HistoryGames  int  // 战绩记录场次
+		Games         []GameStat
+	}
+	// SmurfResult 小号识别结果
+	SmurfResult struct {
+		Likelihood float64  `json:"likelihood"` // 小号可能性 0~1
+		IsSmurf    bool     `json:"isSmurf"`
+		Signals    []string `json:"signals"` // 命中的信号
+	}
+	smurfSignal struct {
+		weight float64
+		hit    bool
+		desc   string
+	}
+)
+
+// CalcUserGameStat 从对局详情中取出用户的基础数据，缓存的对局里可能没有召唤师id，按puuid查找
+func CalcUserGameStat(summonerID int64, puuid string, gameSummary models.GameSummary) (GameStat, error) {
+	userParticipantId, err := FindParticipantID(gameSummary, summonerID, puuid)
+	if err != nil {
+		return GameStat{}, err
+	}
+	var user *models.Participant
+	for i := range gameSummary.Participants {
+		if gameSummary.Participants[i].ParticipantId == userParticipantId {
+			user = &gameSummary.Participants[i]
+		}
+	}
+	if user == nil {
+		return GameStat{}, errors.New("获取用户队伍id失败")
+	}
+	totalHurt := 0
+	for _, participant := range gameSummary.Participants {
+		if participant.TeamId == user.TeamId {
+			totalHurt += participant.Stats.TotalDamageDealtToChampions
+		}
+	}
+	stat := GameStat{
+		Kills:   user.Stats.Kills,
+		Deaths:  user.Stats.Deaths,
+		Assists: user.Stats.Assists,
+		Win:     user.Stats.Win,
+	}
+	if totalHurt > 0 {
+		stat.DamageShare = float64(user.Stats.TotalDamageDealtToChampions) / float64(totalHurt)
+	}
+	return stat, nil
+}
+
+// CalcSmurf 根据账号新旧程度和近期表现估算小号可能性
+// 只有新号信号没有表现信号的玩家只是样本少，不会被判定为小号
+func CalcSmurf(input SmurfInput, conf config.SmurfConf) SmurfResult {
+	res := SmurfResult{Signals: make([]string, 0, 4)}
+	if !conf.Enabled {
+		return res
+	}
+	freshSignals := []smurfSignal{
+		{weight: 0.4, hit: input.SummonerLevel > 0 && input.SummonerLevel <= conf.MaxSummonerLevel,
+			desc: fmt.Sprintf("等级%d", input.SummonerLevel)},
+		{weight: 0.3, hit: input.RankedKnown && input.RankedGames <= conf.MaxRankedGames,
+			desc: fmt.Sprintf("本赛季排位%d场", input.RankedGames)},
+		{weight: 0.3, hit: input.HistoryGames < conf.MinHistoryGames,
+			desc: fmt.Sprintf("战绩仅%d场", input.HistoryGames)},
+	}
+
+	gameCount := len(input.Games)
+	kills, deaths, assists, wins, highGames := 0, 0, 0, 0, 0
+	damageShare := 0.0
+	for _, game := range input.Games {
+		kills += game.Kills
+		deaths += game.Deaths
+		assists += game.Assists
+		damageShare += game.DamageShare
+		if game.Win {
+			wins++
+		}
+		if game.Score >= conf.HighGameScore {
+			highGames++
+		}
+	}
+	avgKDA, avgDamageShare, winRate, highGameRate := 0.0, 0.0, 0.0, 0.0
+	if gameCount > 0 {
+		avgKDA = float64(kills+assists) / math.Max(float64(deaths), 1)
+		avgDamageShare = damageShare / float64(gameCount)
+		winRate = float64(wins) / float64(gameCount)
+		highGameRate = float64(highGames) / float64(gameCount)
+	}
+	perfSignals := []smurfSignal{
+		{weight: 0.3, hit: gameCount > 0 && avgKDA >= conf.HighKDA,
+			desc: fmt.Sprintf("KDA %.1f", avgKDA)},
+		{weight: 0.2, hit: gameCount > 0 && avgDamageShare >= conf.HighDamageShare,
+			desc: fmt.Sprintf("伤害占比%d%%", int(avgDamageShare*100))},
+		{weight: 0.25, hit: gameCount >= 3 && gameCount <= conf.WinRateMaxGames && winRate >= conf.HighWinRate,
+			desc: fmt.Sprintf("%d场胜率%d%%", gameCount, int(winRate*100))},
+		{weight: 0.25, hit: gameCount > 0 && highGameRate >= conf.HighGameRate,
+			desc: fmt.Sprintf("高分局%d/%d", highGames, gameCount)},
+	}
+
+	fresh := sumSmurfSignals(freshSignals, &res)
+	perf := sumSmurfSignals(perfSignals, &res)
+	if fresh == 0 || perf == 0 {
+		res.Likelihood = 0
+		return res
+	}
+	// 表现信号更能说明问题，权重更高
+	res.Likelihood = math.Round((0.4*fresh+0.6*perf)*100) / 100
+	res.IsSmurf = res.Likelihood >= conf.Threshold
+	return res
+}
+
+func sumSmurfSignals(signals []smurfSignal, res *SmurfResult) float64 {
+	total := 0.0
+	for _, signal := range signals {
+		if signal.hit {
+			total += signal.weight
+			res.Signals = append(res.Signals, signal.desc)
+		}
+	}
+	return total
+}
+
+// SmurfLabel 小号标签，不是小号时返回空字符串
+func (u *UserScore) SmurfLabel() string {
+	if !u.Smurf.IsSmurf {
+		return ""
+	}
+	return fmt.Sprintf("【疑似小号%d%%】", int(u.Smurf.Likelihood*100))
+}
+
+// SmurfDetail 命令行展示的小号标签和命中的信号
+func (u *UserScore) SmurfDetail() string {
+	if !u.Smurf.IsSmurf {
+		return ""
+	}
+	return fmt.Sprintf("【疑似小号%d%%:%s】", int(u.Smurf.Likelihood*100), strings.Join(u.Smurf.Signals, ","))
+}
@@ -0,0 +1,189 @@
+package scores
+
+import (
+	"cmp"
+	"fmt"
+	"main.go/config"
+	"math"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	WeightingWindow = "window" // 最近几小时和其他时间分别取平均再按比例合成
+	WeightingDecay  = "decay"  // 按对局距今时间指数衰减
+)
+
+// Weighting 对局加权策略
+type Weighting interface {
+	// Select 挑出参与评分的对局
+	Select(items []GameScoreItem) []GameScoreItem
+	// Weights 每局的权重，不需要归一化
+	Weights(items []GameScoreItem, now time.Time) []float64
+	// String 策略和参数说明
+	String() string
+}
+
+// NewWeighting 根据配置创建加权策略，未知策略使用时间窗口
+func NewWeighting(conf config.WeightingConf) Weighting {
+	var timeWeighting Weighting
+	switch conf.Strategy {
+	case WeightingDecay:
+		timeWeighting = decayWeighting{halfLife: time.Duration(conf.HalfLifeHours * float64(time.Hour))}
+	default:
+		timeWeighting = windowWeighting{
+			window:       time.Duration(conf.WindowHours * float64(time.Hour)),
+			recentWeight: conf.RecentWeight,
+		}
+	}
+	return cappedWeighting{
+		Weighting:  timeWeighting,
+		maxGames:   conf.MaxGames,
+		patchDecay: conf.PatchDecay,
+	}
+}
+
+// windowWeighting 时间窗口：窗口内和窗口外分别取平均，再按recentWeight合成，某一边没有对局时用全部对局平均
+type windowWeighting struct {
+	window       time.Duration
+	recentWeight float64
+}
+
+func (w windowWeighting) Select(items []GameScoreItem) []GameScoreItem {
+	return items
+}
+
+func (w windowWeighting) Weights(items []GameScoreItem, now time.Time) []float64 {
+	recent := make([]bool, len(items))
+	recentCount := 0
+	for i, item := range items {
+		recent[i] = now.Before(item.GameCreationDate.Add(w.window))
+		if recent[i] {
+			recentCount++
+		}
+	}
+	weights := make([]float64, len(items))
+	for i := range items {
+		switch {
+		case recentCount == 0 || recentCount == len(items):
+			weights[i] = 1
+		case recent[i]:
+			weights[i] = w.recentWeight / float64(recentCount)
+		default:
+			weights[i] = (1 - w.recentWeight) / float64(len(items)-recentCount)
+		}
+	}
+	return weights
+}
+
+func (w windowWeighting) String() string {
+	return fmt.Sprintf("时间窗口(最近%s权重%.0f%%)", formatHours(w.window), w.recentWeight*100)
+}
+
+// decayWeighting 指数衰减：每过一个半衰期权重减半
+type decayWeighting struct {
+	halfLife time.Duration
+}
+
+func (w decayWeighting) Select(items []GameScoreItem) []GameScoreItem {
+	return items
+}
+
+func (w decayWeighting) Weights(items []GameScoreItem, now time.Time) []float64 {
+	weights := make([]float64, len(items))
+	for i, item := range items {
+		if w.halfLife <= 0 {
+			weights[i] = 1
+			continue
+		}
+		age := max(now.Sub(item.GameCreationDate), 0)
+		weights[i] = math.Pow(.5, float64(age)/float64(w.halfLife))
+	}
+	return weights
+}
+
+func (w decayWeighting) String() string {
+	return fmt.Sprintf("指数衰减(半衰期%s)", formatHours(w.halfLife))
+}
+
+// cappedWeighting 在时间加权之上限制对局数并按版本降权
+type cappedWeighting struct {
+	Weighting
+	maxGames   int     // 只取最近的几局，0表示不限制
+	patchDecay float64 // 每旧一个版本权重乘以该值，1或0表示不按版本降权
+}
+
+func (w cappedWeighting) Select(items []GameScoreItem) []GameScoreItem {
+	items = w.Weighting.Select(items)
+	if w.maxGames <= 0 || len(items) <= w.maxGames {
+		return items
+	}
+	items = slices.Clone(items)
+	slices.SortStableFunc(items, func(a, b GameScoreItem) int {
+		return b.GameCreationDate.Compare(a.GameCreationDate)
+	})
+	return items[:w.maxGames]
+}
+
+func (w cappedWeighting) Weights(items []GameScoreItem, now time.Time) []float64 {
+	weights := w.Weighting.Weights(items, now)
+	if w.patchDecay <= 0 || w.patchDecay >= 1 {
+		return weights
+	}
+	// 以参与评分的对局里最新的版本为准，往前每差一个版本降一次权
+	patches := make([]string, 0, len(items))
+	for _, item := range items {
+		if patch := Patch(item.GameVersion); patch != "" && !slices.Contains(patches, patch) {
+			patches = append(patches, patch)
+		}
+	}
+	slices.SortFunc(patches, comparePatch)
+	for i, item := range items {
+		idx := slices.Index(patches, Patch(item.GameVersion))
+		if idx < 0 {
+			continue
+		}
+		weights[i] *= math.Pow(w.patchDecay, float64(len(patches)-1-idx))
+	}
+	return weights
+}
+
+func (w cappedWeighting) String() string {
+	sb := strings.Builder{}
+	sb.WriteString(w.Weighting.String())
+	if w.maxGames > 0 {
+		sb.WriteString(fmt.Sprintf(",最多%d局", w.maxGames))
+	}
+	if w.patchDecay > 0 && w.patchDecay < 1 {
+		sb.WriteString(fmt.Sprintf(",每旧一个版本权重x%.2f", w.patchDecay))
+	}
+	return sb.String()
+}
+
+// Patch 从游戏版本中取出大版本号，例如14.3.556.5432返回14.3
+func Patch(gameVersion string) string {
+	parts := strings.SplitN(gameVersion, ".", 3)
+	if len(parts) < 2 {
+		return ""
+	}
+	return parts[0] + "." + parts[1]
+}
+
+// comparePatch 按数字比较版本号，14.10在14.9之后
+func comparePatch(a, b string) int {
+	partsA, partsB := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(partsA) && i < len(partsB); i++ {
+		var numA, numB int
+		_, _ = fmt.Sscan(partsA[i], &numA)
+		_, _ = fmt.Sscan(partsB[i], &numB)
+		if numA != numB {
+			return cmp.Compare(numA, numB)
+		}
+	}
+	return cmp.Compare(len(partsA), len(partsB))
+}
+
+func formatHours(d time.Duration) string {
+	return strings.TrimSuffix(strings.TrimSuffix(fmt.Sprintf("%.1f", d.Hours()), "0"), ".") + "h"
+}
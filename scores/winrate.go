@@ -0,0 +1,100 @@
+package scores
+
+import (
+	"fmt"
+	"main.go/config"
+	"main.go/lcu/models"
+	"math"
+	"slices"
+)
+
+// WinEstimate 胜率预估
+type WinEstimate struct {
+	Probability float64 `json:"probability"` // 我方胜率 0~1
+	Low         float64 `json:"low"`         // 置信区间下限
+	High        float64 `json:"high"`        // 置信区间上限
+	AllyPower   float64 `json:"allyPower"`   // 我方平均实力
+	EnemyPower  float64 `json:"enemyPower"`  // 对方平均实力
+}
+
+// 段位从低到高，黄金为基准
+var tierOrder = []models.RankTier{
+	models.RankTierIron,
+	models.RankTierBronze,
+	models.RankTierSilver,
+	models.RankTierGold,
+	models.RankTierPlatinum,
+	models.RankTierEmerald,
+	models.RankTierDiamond,
+	models.RankTierMaster,
+	models.RankTierGrandMaster,
+	models.RankTierChallenger,
+}
+
+// PlayerPower 玩家实力：评分加上段位、小号、连败的修正
+func PlayerPower(u *UserScore, conf config.WinRateConf) float64 {
+	power := u.Score
+	rank := u.SoloRank
+	if !rank.IsRanked() {
+		rank = u.FlexRank
+	}
+	if idx := slices.Index(tierOrder, rank.Tier); idx >= 0 {
+		power += float64(idx-slices.Index(tierOrder, models.RankTierGold)) * conf.RankStepScore
+	}
+	power += u.Smurf.Likelihood * conf.SmurfBonus
+	for _, tag := range u.Tags {
+		if tag.Name == TagTilt {
+			power -= conf.TiltPenalty
+		}
+	}
+	return power
+}
+
+// TeamPower 队伍平均实力
+func TeamPower(team []*UserScore, conf config.WinRateConf) float64 {
+	if len(team) == 0 {
+		return 0
+	}
+	total := 0.0
+	for _, u := range team {
+		total += PlayerPower(u, conf)
+	}
+	return total / float64(len(team))
+}
+
+// EstimateWinProbability 根据双方玩家预估我方胜率
+func EstimateWinProbability(allies, enemies []*UserScore, conf config.WinRateConf) WinEstimate {
+	allyPower := TeamPower(allies, conf)
+	enemyPower := TeamPower(enemies, conf)
+	// 两队平均值之差的标准差
+	std := conf.PlayerStd * math.Sqrt(1/float64(max(len(allies), 1))+1/float64(max(len(enemies), 1)))
+	estimate := EstimateAgainst(allyPower, enemyPower, std, conf)
+	estimate.AllyPower = allyPower
+	estimate.EnemyPower = enemyPower
+	return estimate
+}
+
+// EstimateAgainst 我方实力对比一个基准实力的胜率，std为实力差的标准差
+func EstimateAgainst(power, baseline, std float64, conf config.WinRateConf) WinEstimate {
+	diff := power - baseline
+	return WinEstimate{
+		Probability: logistic(diff, conf.Scale),
+		Low:         logistic(diff-std, conf.Scale),
+		High:        logistic(diff+std, conf.Scale),
+		AllyPower:   power,
+		EnemyPower:  baseline,
+	}
+}
+
+// String 胜率展示，例如 胜率56%(48%~63%)
+func (w WinEstimate) String() string {
+	return fmt.Sprintf("胜率%d%%(%d%%~%d%%)", int(math.Round(w.Probability*100)), int(math.Round(w.Low*100)),
+		int(math.Round(w.High*100)))
+}
+
+func logistic(diff, scale float64) float64 {
+	if scale <= 0 {
+		scale = 1
+	}
+	return 1 / (1 + math.Exp(-diff/scale))
+}
@@ -0,0 +1,293 @@
+package script
+
+import (
+	"errors"
+	"fmt"
+	"go.starlark.net/starlark"
+	"go.starlark.net/syntax"
+	"go.uber.org/zap"
+	"main.go/config"
+	"main.go/logger"
+	"main.go/notes"
+	"main.go/scores"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// 脚本可以定义的钩子函数
+const (
+	HookPlayerScored  = "on_player_scored" // on_player_scored(player) 返回标签名或标签列表
+	HookLobbyReady    = "on_lobby_ready"   // on_lobby_ready(lobby) 返回提醒内容或提醒列表
+	HookFormatMessage = "format_message"   // format_message(player[, message]) 返回替换后的消息
+)
+
+// Script 一个已加载的脚本
+type Script struct {
+	Name    string
+	globals starlark.StringDict
+}
+
+var (
+	mu      = sync.RWMutex{}
+	scripts []*Script
+	conf    = config.Default().Script
+)
+
+// Dir 脚本目录，相对路径以当前配置文件所在目录为准
+func Dir(c config.ScriptConf) string {
+	if filepath.IsAbs(c.Dir) {
+		return c.Dir
+	}
+	return filepath.Join(filepath.Dir(config.Path()), c.Dir)
+}
+
+// Init 加载脚本目录下的全部.star文件，单个脚本出错不影响其他脚本
+func Init(c config.ScriptConf) error {
+	loaded, errs, err := loadDir(c)
+	mu.Lock()
+	scripts, conf = loaded, c
+	mu.Unlock()
+	if err != nil {
+		return err
+	}
+	for _, loadErr := range errs {
+		logger.L().Warn("加载脚本失败", zap.Error(loadErr))
+	}
+	if len(loaded) > 0 {
+		names := make([]string, 0, len(loaded))
+		for _, s := range loaded {
+			names = append(names, s.Name)
+		}
+		logger.L().Info("已加载脚本", zap.String("scripts", strings.Join(names, ",")))
+	}
+	return nil
+}
+
+// Validate 试加载脚本目录下的全部脚本，返回加载失败的原因，不影响当前已加载的脚本
+func Validate(c config.ScriptConf) error {
+	_, errs, err := loadDir(c)
+	if err != nil {
+		return err
+	}
+	return errors.Join(errs...)
+}
+
+// loadDir 加载脚本目录，errs是单个脚本的错误，err是目录读取错误
+func loadDir(c config.ScriptConf) (loaded []*Script, errs []error, err error) {
+	if !c.Enabled {
+		return nil, nil, nil
+	}
+	dir := Dir(c)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".star" {
+			continue
+		}
+		s, loadErr := load(filepath.Join(dir, entry.Name()), c)
+		if loadErr != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", entry.Name(), loadErr))
+			continue
+		}
+		loaded = append(loaded, s)
+	}
+	return loaded, errs, nil
+}
+
+// Loaded 已加载的脚本名
+func Loaded() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+	names := make([]string, 0, len(scripts))
+	for _, s := range scripts {
+		names = append(names, s.Name)
+	}
+	return names
+}
+
+// load 执行脚本顶层代码，得到冻结后的全局变量
+func load(path string, c config.ScriptConf) (*Script, error) {
+	name := filepath.Base(path)
+	thread := newThread(name, c)
+	timer := time.AfterFunc(timeout(c), func() { thread.Cancel("加载超时") })
+	defer timer.Stop()
+	globals, err := starlark.ExecFileOptions(&syntax.FileOptions{}, thread, path, nil, predeclared)
+	if err != nil {
+		return nil, err
+	}
+	globals.Freeze()
+	return &Script{Name: name, globals: globals}, nil
+}
+
+// OnPlayerScored 玩家评分完成后调用脚本，脚本返回的标签加到玩家的行为标签里
+func OnPlayerScored(u *scores.UserScore) {
+	player := playerValue(u)
+	for _, s := range current() {
+		res, ok := s.call(HookPlayerScored, player)
+		if !ok {
+			continue
+		}
+		tagNames, err := toStrings(res)
+		if err != nil {
+			logger.L().Warn("脚本返回值错误", zap.String("script", s.Name), zap.String("hook", HookPlayerScored), zap.Error(err))
+			continue
+		}
+		for _, name := range tagNames {
+			if !slices.ContainsFunc(u.Tags, func(tag scores.Tag) bool { return tag.Name == name }) {
+				u.Tags = append(u.Tags, scores.Tag{Name: name})
+			}
+		}
+	}
+}
+
+// OnLobbyReady 一方玩家全部评分完成后调用脚本，返回脚本给出的提醒
+func OnLobbyReady(relation notes.Relation, queueID int, players []*scores.UserScore) []string {
+	list := current()
+	if len(list) == 0 {
+		return nil
+	}
+	lobby := lobbyValue(relation, queueID, players)
+	messages := make([]string, 0)
+	for _, s := range list {
+		res, ok := s.call(HookLobbyReady, lobby)
+		if !ok {
+			continue
+		}
+		items, err := toStrings(res)
+		if err != nil {
+			logger.L().Warn("脚本返回值错误", zap.String("script", s.Name), zap.String("hook", HookLobbyReady), zap.Error(err))
+			continue
+		}
+		for _, item := range items {
+			messages = append(messages, fmt.Sprintf("[%s]%s", s.Name, item))
+		}
+	}
+	return messages
+}
+
+// FormatMessage 发送前让脚本修改玩家的消息，多个脚本依次修改，返回None时保持不变
+func FormatMessage(u *scores.UserScore, message string) string {
+	list := current()
+	if len(list) == 0 {
+		return message
+	}
+	player := playerValue(u)
+	for _, s := range list {
+		fn, ok := s.globals[HookFormatMessage].(*starlark.Function)
+		if !ok {
+			continue
+		}
+		args := []starlark.Value{player}
+		if fn.NumParams() > 1 {
+			args = append(args, starlark.String(message))
+		}
+		res, ok := s.call(HookFormatMessage, args...)
+		if !ok || res == starlark.None {
+			continue
+		}
+		text, isStr := starlark.AsString(res)
+		if !isStr {
+			logger.L().Warn("脚本返回值错误", zap.String("script", s.Name), zap.String("hook", HookFormatMessage),
+				zap.String("type", res.Type()))
+			continue
+		}
+		message = text
+	}
+	return message
+}
+
+// current 当前已加载的脚本
+func current() []*Script {
+	mu.RLock()
+	defer mu.RUnlock()
+	return scripts
+}
+
+// call 调用脚本中的钩子函数，超时、报错和panic都只影响这一次调用
+func (s *Script) call(hook string, args ...starlark.Value) (res starlark.Value, ok bool) {
+	fn, exists := s.globals[hook]
+	if !exists {
+		return nil, false
+	}
+	mu.RLock()
+	c := conf
+	mu.RUnlock()
+	defer func() {
+		if r := recover(); r != nil {
+			logger.L().Error("脚本执行异常", zap.String("script", s.Name), zap.String("hook", hook), zap.Any("panic", r))
+			res, ok = nil, false
+		}
+	}()
+	thread := newThread(s.Name, c)
+	timer := time.AfterFunc(timeout(c), func() { thread.Cancel("执行超时") })
+	defer timer.Stop()
+	res, err := starlark.Call(thread, fn, args, nil)
+	if err != nil {
+		var evalErr *starlark.EvalError
+		if errors.As(err, &evalErr) {
+			err = errors.New(evalErr.Backtrace())
+		}
+		logger.L().Warn("脚本执行失败", zap.String("script", s.Name), zap.String("hook", hook), zap.Error(err))
+		return nil, false
+	}
+	return res, true
+}
+
+// newThread 每次调用使用独立的线程，print输出到命令行
+func newThread(name string, c config.ScriptConf) *starlark.Thread {
+	thread := &starlark.Thread{
+		Name: name,
+		Print: func(_ *starlark.Thread, msg string) {
+			fmt.Println("[脚本"+name+"]", msg)
+		},
+		Load: func(_ *starlark.Thread, module string) (starlark.StringDict, error) {
+			return nil, errors.New("脚本不支持load")
+		},
+	}
+	if c.MaxSteps > 0 {
+		thread.SetMaxExecutionSteps(c.MaxSteps)
+	}
+	return thread
+}
+
+func timeout(c config.ScriptConf) time.Duration {
+	if c.TimeoutMs <= 0 {
+		return time.Second
+	}
+	return time.Duration(c.TimeoutMs) * time.Millisecond
+}
+
+// toStrings 钩子返回值转成字符串列表，支持None、字符串、列表和元组
+func toStrings(v starlark.Value) ([]string, error) {
+	if v == nil || v == starlark.None {
+		return nil, nil
+	}
+	if text, ok := starlark.AsString(v); ok {
+		return []string{text}, nil
+	}
+	iterable, ok := v.(starlark.Iterable)
+	if !ok {
+		return nil, fmt.Errorf("需要字符串或字符串列表,实际为%s", v.Type())
+	}
+	res := make([]string, 0)
+	iter := iterable.Iterate()
+	defer iter.Done()
+	var item starlark.Value
+	for iter.Next(&item) {
+		text, ok := starlark.AsString(item)
+		if !ok {
+			return nil, fmt.Errorf("列表元素需要字符串,实际为%s", item.Type())
+		}
+		res = append(res, text)
+	}
+	return res, nil
+}
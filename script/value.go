@@ -0,0 +1,112 @@
+package script
+
+import (
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+	"main.go/notes"
+	"main.go/scores"
+	"time"
+)
+
+// predeclared 脚本可以直接使用的内置函数
+var predeclared = starlark.StringDict{
+	"now": starlark.NewBuiltin("now", func(_ *starlark.Thread, _ *starlark.Builtin, args starlark.Tuple,
+		kwargs []starlark.Tuple) (starlark.Value, error) {
+		return starlark.MakeInt64(time.Now().Unix()), nil
+	}),
+}
+
+// playerValue 玩家信息，脚本只读
+func playerValue(u *scores.UserScore) starlark.Value {
+	tags := make([]starlark.Value, 0, len(u.Tags))
+	for _, tag := range u.Tags {
+		tags = append(tags, starlark.String(tag.Name))
+	}
+	kda := make([]starlark.Value, 0, len(u.CurrKDA))
+	for _, item := range u.CurrKDA {
+		kda = append(kda, starlark.Tuple{starlark.MakeInt(item[0]), starlark.MakeInt(item[1]), starlark.MakeInt(item[2])})
+	}
+	history := make([]starlark.Value, 0, len(u.History))
+	for _, game := range u.History {
+		if len(game.Participants) == 0 {
+			continue
+		}
+		participant := game.Participants[0]
+		history = append(history, newStruct(starlark.StringDict{
+			"game_id":     starlark.MakeInt64(game.GameId),
+			"queue_id":    starlark.MakeInt(int(game.QueueId)),
+			"time":        starlark.MakeInt64(game.GameCreationDate.Unix()),
+			"duration":    starlark.MakeInt(game.GameDuration),
+			"champion_id": starlark.MakeInt(int(participant.ChampionId)),
+			"win":         starlark.Bool(participant.Stats.Win),
+			"kills":       starlark.MakeInt(participant.Stats.Kills),
+			"deaths":      starlark.MakeInt(participant.Stats.Deaths),
+			"assists":     starlark.MakeInt(participant.Stats.Assists),
+		}))
+	}
+	var note starlark.Value = starlark.None
+	if n, ok := notes.Get(u.Puuid); ok {
+		noteTags := make([]starlark.Value, 0, len(n.Tags))
+		for _, tag := range n.Tags {
+			noteTags = append(noteTags, starlark.String(tag))
+		}
+		note = newStruct(starlark.StringDict{
+			"list":   starlark.String(n.List),
+			"text":   starlark.String(n.Text),
+			"tags":   starlark.Tuple(noteTags),
+			"rating": starlark.MakeInt(n.Rating),
+		})
+	}
+	encounters := notes.Encounters(u.Puuid)
+	allyCount, enemyCount := 0, 0
+	for _, encounter := range encounters {
+		if encounter.Relation == notes.RelationAlly {
+			allyCount++
+		} else {
+			enemyCount++
+		}
+	}
+	return newStruct(starlark.StringDict{
+		"summoner_id":  starlark.MakeInt64(u.SummonerID),
+		"puuid":        starlark.String(u.Puuid),
+		"name":         starlark.String(u.SummonerName),
+		"score":        starlark.Float(u.Score),
+		"score_low":    starlark.Float(u.ScoreLow),
+		"score_high":   starlark.Float(u.ScoreHigh),
+		"sample_count": starlark.MakeInt(u.SampleCount),
+		"confident":    starlark.Bool(u.Confident),
+		"horse":        starlark.String(u.HorseLabel()),
+		"scorer":       starlark.String(u.Scorer),
+		"is_aram":      starlark.Bool(u.IsARAM),
+		"solo_rank":    starlark.String(u.SoloRank.String()),
+		"flex_rank":    starlark.String(u.FlexRank.String()),
+		"solo_tier":    starlark.String(u.SoloRank.Tier),
+		"flex_tier":    starlark.String(u.FlexRank.Tier),
+		"smurf":        starlark.Float(u.Smurf.Likelihood),
+		"tags":         starlark.Tuple(tags),
+		"kda":          starlark.Tuple(kda),
+		"history":      starlark.Tuple(history),
+		"note":         note,
+		"met_as_ally":  starlark.MakeInt(allyCount),
+		"met_as_enemy": starlark.MakeInt(enemyCount),
+	})
+}
+
+// lobbyValue 一方玩家信息，脚本只读
+func lobbyValue(relation notes.Relation, queueID int, players []*scores.UserScore) starlark.Value {
+	list := make([]starlark.Value, 0, len(players))
+	for _, player := range players {
+		list = append(list, playerValue(player))
+	}
+	return newStruct(starlark.StringDict{
+		"relation": starlark.String(relation),
+		"queue_id": starlark.MakeInt(queueID),
+		"players":  starlark.Tuple(list),
+	})
+}
+
+func newStruct(fields starlark.StringDict) starlark.Value {
+	s := starlarkstruct.FromStringDict(starlarkstruct.Default, fields)
+	s.Freeze()
+	return s
+}
@@ -0,0 +1,316 @@
+package staticdata
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"main.go/store"
+)
+
+const (
+	LocaleZhCN = "zh_CN"
+	LocaleEnUS = "en_US"
+
+	storeKind = "staticdata"
+	storeKey  = "bundle"
+
+	iconBaseURL = "https://ddragon.leagueoflegends.com/cdn/"
+)
+
+const (
+	KindChampion = "champion"
+	KindItem     = "item"
+	KindRune     = "rune"
+	KindSpell    = "spell"
+)
+
+// kindNames 各类型的中文名，找不到时显示为 英雄157
+var kindNames = map[string]string{
+	KindChampion: "英雄",
+	KindItem:     "装备",
+	KindRune:     "符文",
+	KindSpell:    "召唤师技能",
+}
+
+// Locales 支持的语言
+var Locales = []string{LocaleZhCN, LocaleEnUS}
+
+// ErrOlderVersion 导入的数据包版本不比当前的新
+var ErrOlderVersion = errors.New("数据包版本不比当前的新")
+
+// embedded 内置数据包，英雄、符文和召唤师技能是完整的，装备只收录了常用的，部分新装备只有英文名
+//
+//go:embed bundle.json
+var embedded []byte
+
+type (
+	// Entry 英雄、装备、符文或召唤师技能
+	Entry struct {
+		ID   int               `json:"id"`
+		Key  string            `json:"key,omitempty"`  // 英文标识，例如 Yasuo、SummonerFlash
+		Name map[string]string `json:"name"`           // 语言 -> 名字
+		Tags []string          `json:"tags,omitempty"` // 例如 Fighter、Mage，符文为Style/Keystone/Shard
+		Icon string            `json:"icon,omitempty"` // Data Dragon图片文件名
+	}
+	// Bundle 静态数据包，格式参考Data Dragon，每个版本一个
+	Bundle struct {
+		Version   string   `json:"version"` // 游戏版本，例如 15.6.1
+		Locales   []string `json:"locales"`
+		Champions []Entry  `json:"champions"`
+		Items     []Entry  `json:"items"`
+		Runes     []Entry  `json:"runes"`
+		Spells    []Entry  `json:"spells"`
+	}
+	// index 按id查找的数据包
+	index struct {
+		bundle *Bundle
+		kinds  map[string]map[int]Entry
+	}
+)
+
+var (
+	mu      = sync.RWMutex{}
+	current = mustIndex(embedded)
+	locale  = LocaleZhCN
+)
+
+// LocalName 指定语言的名字，没有时依次用中文、英文名和key
+func (e Entry) LocalName(lang string) string {
+	for _, l := range []string{lang, LocaleZhCN, LocaleEnUS} {
+		if name := e.Name[l]; name != "" {
+			return name
+		}
+	}
+	return e.Key
+}
+
+// HasTag 是否有该标签，不区分大小写
+func (e Entry) HasTag(tag string) bool {
+	return slices.ContainsFunc(e.Tags, func(t string) bool { return strings.EqualFold(t, tag) })
+}
+
+// Init 设置显示语言，数据目录中导入过不比内置旧的数据包时使用导入的
+func Init(lang string) error {
+	mu.Lock()
+	locale = lang
+	mu.Unlock()
+	imported := &Bundle{}
+	err := store.Load(storeKind, storeKey, imported)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	idx, err := newIndex(imported)
+	if err != nil {
+		return fmt.Errorf("导入的静态数据无效: %w", err)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if CompareVersion(idx.bundle.Version, current.bundle.Version) >= 0 {
+		current = idx
+	}
+	return nil
+}
+
+// Import 从本地文件导入数据包并保存到数据目录，版本不比当前的新时返回ErrOlderVersion
+// force为true时强制导入，比内置数据包旧的版本重启后不再使用
+func Import(path string, force bool) (*Bundle, error) {
+	bts, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	b := &Bundle{}
+	if err = json.Unmarshal(bts, b); err != nil {
+		return nil, err
+	}
+	idx, err := newIndex(b)
+	if err != nil {
+		return nil, err
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if !force && CompareVersion(idx.bundle.Version, current.bundle.Version) <= 0 {
+		return nil, fmt.Errorf("%w: %s,当前为%s", ErrOlderVersion, idx.bundle.Version, current.bundle.Version)
+	}
+	if err = store.Save(storeKind, storeKey, idx.bundle); err != nil {
+		return nil, err
+	}
+	current = idx
+	return idx.bundle, nil
+}
+
+// Current 当前使用的数据包
+func Current() *Bundle {
+	mu.RLock()
+	defer mu.RUnlock()
+	return current.bundle
+}
+
+// Version 当前数据包的版本
+func Version() string {
+	return Current().Version
+}
+
+// Lookup 按类型和id查找
+func Lookup(kind string, id int) (Entry, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	e, ok := current.kinds[kind][id]
+	return e, ok
+}
+
+// Find 按key或任一语言的名字查找，不区分大小写
+func Find(kind, name string) (Entry, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	for _, e := range current.kinds[kind] {
+		if strings.EqualFold(e.Key, name) {
+			return e, true
+		}
+		for _, n := range e.Name {
+			if strings.EqualFold(n, name) {
+				return e, true
+			}
+		}
+	}
+	return Entry{}, false
+}
+
+// ParseRef 解析配置中的id或名字，名字按key或任一语言的名字查找，例如 4、闪现、SummonerFlash
+func ParseRef(kind string, bts []byte) (int, error) {
+	var id int
+	if err := json.Unmarshal(bts, &id); err == nil {
+		return id, nil
+	}
+	var name string
+	if err := json.Unmarshal(bts, &name); err != nil {
+		return 0, err
+	}
+	if id, err := strconv.Atoi(name); err == nil {
+		return id, nil
+	}
+	e, ok := Find(kind, name)
+	if !ok {
+		return 0, fmt.Errorf("未知的%s:%s", kindNames[kind], name)
+	}
+	return e.ID, nil
+}
+
+// Champion 按id查找英雄
+func Champion(id int) (Entry, bool) {
+	return Lookup(KindChampion, id)
+}
+
+// ChampionName 英雄名，找不到时为 英雄157
+func ChampionName(id int) string {
+	return name(KindChampion, id)
+}
+
+// ItemName 装备名
+func ItemName(id int) string {
+	return name(KindItem, id)
+}
+
+// RuneName 符文名
+func RuneName(id int) string {
+	return name(KindRune, id)
+}
+
+// SpellName 召唤师技能名
+func SpellName(id int) string {
+	return name(KindSpell, id)
+}
+
+// IconURL Data Dragon上的图片地址，没有图片时为空
+func IconURL(kind string, e Entry) string {
+	if e.Icon == "" {
+		return ""
+	}
+	if kind == KindRune {
+		return iconBaseURL + "img/" + e.Icon
+	}
+	return iconBaseURL + Version() + "/img/" + kind + "/" + e.Icon
+}
+
+func name(kind string, id int) string {
+	mu.RLock()
+	defer mu.RUnlock()
+	e, ok := current.kinds[kind][id]
+	if !ok {
+		return kindNames[kind] + strconv.Itoa(id)
+	}
+	return e.LocalName(locale)
+}
+
+// CompareVersion 按数字比较版本号，例如 15.10.1 > 15.9.1
+func CompareVersion(a, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := range max(len(as), len(bs)) {
+		var x, y int
+		if i < len(as) {
+			x, _ = strconv.Atoi(as[i])
+		}
+		if i < len(bs) {
+			y, _ = strconv.Atoi(bs[i])
+		}
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// newIndex 检查数据包并按id建立索引
+func newIndex(b *Bundle) (*index, error) {
+	if b.Version == "" {
+		return nil, errors.New("缺少version")
+	}
+	if len(b.Champions) == 0 {
+		return nil, errors.New("缺少champions")
+	}
+	idx := &index{bundle: b, kinds: map[string]map[int]Entry{}}
+	for kind, entries := range map[string][]Entry{
+		KindChampion: b.Champions,
+		KindItem:     b.Items,
+		KindRune:     b.Runes,
+		KindSpell:    b.Spells,
+	} {
+		byID := make(map[int]Entry, len(entries))
+		for _, e := range entries {
+			if _, ok := byID[e.ID]; ok {
+				return nil, fmt.Errorf("%s的id重复: %d", kind, e.ID)
+			}
+			if len(e.Name) == 0 {
+				return nil, fmt.Errorf("%s %d缺少名字", kind, e.ID)
+			}
+			byID[e.ID] = e
+		}
+		idx.kinds[kind] = byID
+	}
+	return idx, nil
+}
+
+func mustIndex(bts []byte) *index {
+	b := &Bundle{}
+	err := json.Unmarshal(bts, b)
+	var idx *index
+	if err == nil {
+		idx, err = newIndex(b)
+	}
+	if err != nil {
+		panic("内置静态数据无效: " + err.Error())
+	}
+	return idx
+}
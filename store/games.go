@@ -0,0 +1,64 @@
+package store
+
+import (
+	"go.uber.org/zap"
+	"main.go/lcu/models"
+	"main.go/logger"
+	"strconv"
+)
+
+const gameStoreKind = "games" // 对局详情缓存目录
+
+// SaveGame 缓存对局详情，已经结束的对局不会再变化
+func SaveGame(gameSummary *models.GameSummary) error {
+	return Save(gameStoreKind, strconv.FormatInt(gameSummary.GameId, 10), gameSummary)
+}
+
+// LoadGame 读取缓存的对局详情
+func LoadGame(gameID int64) (*models.GameSummary, bool) {
+	gameSummary := &models.GameSummary{}
+	if err := Load(gameStoreKind, strconv.FormatInt(gameID, 10), gameSummary); err != nil {
+		return nil, false
+	}
+	return gameSummary, true
+}
+
+// DeleteGame 删除缓存的对局详情
+func DeleteGame(gameID int64) error {
+	return Delete(gameStoreKind, strconv.FormatInt(gameID, 10))
+}
+
+// ListGameIDs 列出所有缓存的对局id
+func ListGameIDs() ([]int64, error) {
+	keys, err := List(gameStoreKind)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int64, 0, len(keys))
+	for _, key := range keys {
+		id, err := strconv.ParseInt(key, 10, 64)
+		if err != nil {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// LoadGames 读取所有缓存的对局详情
+func LoadGames() ([]models.GameSummary, error) {
+	ids, err := ListGameIDs()
+	if err != nil {
+		return nil, err
+	}
+	games := make([]models.GameSummary, 0, len(ids))
+	for _, id := range ids {
+		gameSummary, ok := LoadGame(id)
+		if !ok {
+			logger.L().Warn("读取缓存对局失败", zap.Int64("gameID", id))
+			continue
+		}
+		games = append(games, *gameSummary)
+	}
+	return games, nil
+}
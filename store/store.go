@@ -0,0 +1,145 @@
+package store
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+const fileExt = ".json"
+
+var (
+	mu      = sync.RWMutex{}
+	dataDir = "data"
+)
+
+// Init 设置本地数据目录
+func Init(dir string) {
+	mu.Lock()
+	dataDir = dir
+	mu.Unlock()
+}
+
+// Dir 当前数据目录
+func Dir() string {
+	mu.RLock()
+	defer mu.RUnlock()
+	return dataDir
+}
+
+func filePath(kind, key string) string {
+	return filepath.Join(Dir(), kind, key+fileExt)
+}
+
+// Save 以json格式保存一条记录，kind为分类目录，key为文件名
+func Save(kind, key string, v any) error {
+	bts, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	path := filePath(kind, key)
+	if err = os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	//先写临时文件再重命名，避免程序中途退出留下半个文件；临时文件名随机，同时保存同一条记录时互不影响
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	_, err = tmp.Write(bts)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(tmp.Name(), 0o644)
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), path)
+	}
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+	}
+	return err
+}
+
+// Load 读取一条记录
+func Load(kind, key string, v any) error {
+	bts, err := os.ReadFile(filePath(kind, key))
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bts, v)
+}
+
+// Delete 删除一条记录
+func Delete(kind, key string) error {
+	err := os.Remove(filePath(kind, key))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+// List 列出某个分类下所有记录的key
+func List(kind string) ([]string, error) {
+	entries, err := os.ReadDir(filepath.Join(Dir(), kind))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, fileExt) {
+			continue
+		}
+		keys = append(keys, strings.TrimSuffix(name, fileExt))
+	}
+	return keys, nil
+}
+
+// KindStat 一个分类目录的统计
+type KindStat struct {
+	Kind  string `json:"kind"`
+	Count int    `json:"count"` // 记录条数
+	Bytes int64  `json:"bytes"` // 占用空间
+}
+
+// Stats 统计数据目录下每个分类的记录条数和占用空间
+func Stats() ([]KindStat, error) {
+	entries, err := os.ReadDir(Dir())
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	res := make([]KindStat, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		stat := KindStat{Kind: entry.Name()}
+		files, err := os.ReadDir(filepath.Join(Dir(), entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range files {
+			if file.IsDir() || !strings.HasSuffix(file.Name(), fileExt) {
+				continue
+			}
+			info, err := file.Info()
+			if err != nil {
+				continue
+			}
+			stat.Count++
+			stat.Bytes += info.Size()
+		}
+		res = append(res, stat)
+	}
+	return res, nil
+}
@@ -7,57 +7,163 @@ import (
 	"errors"
 	"fmt"
 	"github.com/avast/retry-go"
-	"github.com/getlantern/systray"
 	"github.com/gorilla/websocket"
-	amqp "github.com/rabbitmq/amqp091-go"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
-	"main.go/checkBox"
+	"main.go/config"
 	"main.go/initialize"
 	"main.go/lcu"
 	"main.go/lcu/models"
+	"main.go/liveclient"
+	"main.go/loadout"
+	"main.go/logger"
 	"main.go/mq"
+	"main.go/mqtt"
+	"main.go/notes"
+	"main.go/runes"
 	"main.go/scores"
+	"main.go/script"
+	"main.go/staticdata"
+	"main.go/store"
+	"main.go/timers"
 	"main.go/utils"
 	"net/http"
+	"os"
+	"os/signal"
 	"slices"
 	"strings"
 	"sync"
+	"syscall"
 	"time"
 )
 
 // TalentScout 一个集成控制中心
 type TalentScout struct {
-	ctx          context.Context
-	httpSrv      *http.Server
-	lcuPort      int
-	lcuToken     string
-	lcuActive    bool
-	currSummoner *models.CurrSummoner
-	cancel       func()
-	mu           *sync.Mutex
-	GameState    GameState
-	MqConn       *amqp.Connection
-	autoAccept   bool
+	ctx           context.Context
+	httpSrv       *http.Server
+	lcuPort       int
+	lcuToken      string
+	lcuActive     bool
+	currSummoner  *models.CurrSummoner
+	cancel        func()
+	mu            *sync.Mutex
+	GameState     GameState
+	broker        mq.Broker            // 消息队列，未开启时为空
+	sink          *mqtt.Sink           // MQTT推送，未开启时为空
+	live          *liveclient.Snapshot // 游戏内记分板
+	liveCancel    func()               // 停止轮询游戏内数据，不在游戏中时为空
+	timers        *timers.Tracker      // 游戏内计时，不在游戏中时为空
+	autoAccept    bool
+	ui            UI                  // 通知栏或无界面模式
+	headless      bool                // 是否无界面模式
+	alerts        []Alert             // 最近的玩家提醒
+	allyScores    []*scores.UserScore // 英雄选择阶段我方评分，用于进入游戏后预估胜率
+	locked        lockedPick          // 本局已按哪个英雄和位置导入过符文
+	encountersFor string              // 已从缓存对局补全过相遇记录的召唤师puuid，切换账号时重新补全
+	log           *zap.Logger         // 诊断日志，给用户看的报告仍然输出到命令行
 }
 
+// Options 启动参数
+type Options struct {
+	ConfigPath string // 配置文件路径
+	LogLevel   string // 日志级别，为空时使用配置文件
+	LCUPort    int    // 指定LOL客户端端口，和LCUToken同时指定时不再从客户端进程读取
+	LCUToken   string // 指定LOL客户端token
+	// Headless 无界面模式，不启动通知栏，开关通过HTTP接口控制，日志写入文件
+	Headless     bool
+	NoAutoAccept bool        // 启动时关闭自动接受对局
+	Logger       *zap.Logger // 诊断日志，为空时按配置文件创建
+}
+
+// NewTalentScout 使用默认配置文件创建
 func NewTalentScout() *TalentScout {
+	return NewTalentScoutWithOptions(Options{ConfigPath: config.DefaultPath})
+}
+
+// NewTalentScoutWithOptions 按启动参数创建
+func NewTalentScoutWithOptions(opts Options) *TalentScout {
+	if err := Setup(opts); err != nil {
+		logger.L().Warn("读取配置文件失败,使用默认配置", zap.Error(err))
+	}
+	scoringConf := config.Get().Scoring
+	logger.L().Info("评分模型", zap.Stringer("scorer",
+		scores.NewQueueScorer(scoringConf, 0, scores.NewWeighting(config.Get().Weighting))),
+		zap.String("compare", scoringConf.Compare))
 	ctx, cancel := context.WithCancel(context.Background())
 	ts := &TalentScout{
-		ctx:    ctx,
-		cancel: cancel,
-		mu:     &sync.Mutex{},
-		//MqConn: mq.InitMQ(), 启用消息队列
-		autoAccept: true,
+		ctx:        ctx,
+		cancel:     cancel,
+		mu:         &sync.Mutex{},
+		broker:     mq.New(config.Get().MQ),
+		sink:       mqtt.New(config.Get().MQTT),
+		autoAccept: !opts.NoAutoAccept,
+		lcuPort:    opts.LCUPort,
+		lcuToken:   opts.LCUToken,
+		headless:   opts.Headless,
+		log:        logger.L(),
+	}
+	if !ts.headless {
+		ts.ui = newTrayUI()
+	}
+	// 用 -tags headless 编译时没有通知栏
+	if ts.ui == nil {
+		ts.headless = true
+		ts.ui = newHeadlessUI()
 	}
 	return ts
 }
 
+// Setup 加载配置、日志、数据目录和脚本，配置文件读取失败时使用默认配置并返回错误
+func Setup(opts Options) error {
+	err := config.Init(opts.ConfigPath)
+	if opts.LogLevel != "" {
+		c := config.Get()
+		c.Log.Level = opts.LogLevel
+		config.Set(c)
+	}
+	if opts.Logger != nil {
+		logger.Set(opts.Logger)
+	} else if logErr := logger.Init(config.Get().Log); logErr != nil {
+		logger.L().Warn("创建日志失败,只输出到命令行", zap.Error(logErr))
+	}
+	lcu.SetLogger(logger.L().Named("lcu"))
+	scores.SetLogger(logger.L().Named("scores"))
+	store.Init(config.Get().DataDir)
+	if dataErr := staticdata.Init(config.Get().StaticData.Locale); dataErr != nil {
+		logger.L().Warn("加载导入的静态数据失败,使用内置数据", zap.Error(dataErr))
+	}
+	if runesErr := runes.Init(config.Get().Runes); runesErr != nil {
+		logger.L().Warn("加载符文预设失败", zap.Error(runesErr))
+	}
+	if loadoutErr := loadout.Init(config.Get().Loadout); loadoutErr != nil {
+		logger.L().Warn("加载召唤师技能和装备方案预设失败", zap.Error(loadoutErr))
+	}
+	if scriptErr := script.Init(config.Get().Script); scriptErr != nil {
+		logger.L().Warn("加载脚本失败", zap.Error(scriptErr))
+	}
+	return err
+}
+
+// lobbyLogger 带上当前对局id和客户端状态的日志
+func (ts *TalentScout) lobbyLogger(phase models.GameFlow) *zap.Logger {
+	var gameID int64
+	if session, err := lcu.QueryGameFlowSession(); err == nil {
+		gameID = session.GameData.GameId
+	}
+	return logger.Lobby(ts.log, gameID, string(phase))
+}
+
 // updateAutoAccept 修改是否自动接受对局
 func (ts *TalentScout) updateAutoAccept(flag bool) {
 	ts.mu.Lock()
 	ts.autoAccept = flag
 	ts.mu.Unlock()
+	//提醒用户
+	if flag {
+		fmt.Println("已设置自动接受对局")
+	} else {
+		fmt.Println("已取消自动接受对局")
+	}
 }
 
 // updateGameState 更新客户端状态
@@ -65,10 +171,12 @@ func (ts *TalentScout) updateGameState(state GameState) {
 	ts.mu.Lock()
 	ts.GameState = state
 	ts.mu.Unlock()
+	ts.sink.PublishState(string(state))
 }
 
 // CalcTeamScore 计算队伍成员得分
 func (ts *TalentScout) CalcTeamScore() {
+	start := time.Now()
 	var summonerIDList []int64
 	var sessionId string
 	for i := 0; i < 3; i++ {
@@ -83,23 +191,26 @@ func (ts *TalentScout) CalcTeamScore() {
 	if len(summonerIDList) == 0 {
 		return
 	}
-	fmt.Println("队伍人员列表:", summonerIDList)
+	log := ts.lobbyLogger(models.GameFlowChampionSelect)
+	announcedAt := ts.announce(log, sessionId)
+	log.Debug("队伍人员列表", zap.Int64s("summonerIDs", summonerIDList))
+	queueID := currQueueID()
 	// 查询所有用户的信息并计算得分
 	g := errgroup.Group{}
 	summonerScores := make([]*scores.UserScore, 0, 5)
 	mu := sync.Mutex{}
 	summonerIDMapInfo, err := listSummoner(summonerIDList)
 	if err != nil {
-		fmt.Println("查询召唤师信息失败")
+		log.Error("查询召唤师信息失败", zap.Error(err))
 		return
 	}
 	for _, summoner := range summonerIDMapInfo {
 		summoner := summoner
 		summonerID := summoner.SummonerId
 		g.Go(func() error {
-			actScore, err := GetUserScore(summoner) //直接拿到评分
+			actScore, err := GetUserScore(summoner, queueID) //直接拿到评分
 			if err != nil {
-				fmt.Println("计算用户", summonerID, "得分失败")
+				log.Warn("计算用户得分失败", zap.Error(err), zap.Int64("summonerID", summonerID))
 				return nil
 			}
 			mu.Lock()
@@ -113,18 +224,26 @@ func (ts *TalentScout) CalcTeamScore() {
 	slices.SortFunc(summonerScores, func(a, b *scores.UserScore) int {
 		return cmp.Compare(b.Score, a.Score)
 	})
+	ts.checkPlayerNotes(summonerScores, notes.RelationAlly)
+	ts.runLobbyScripts(summonerScores, notes.RelationAlly, queueID)
+	ts.mu.Lock()
+	ts.allyScores = summonerScores
+	ts.mu.Unlock()
+	ts.sink.PublishLobby(mqtt.TopicAllies, summonerScores)
 
 	var MsgList []string
 	allMsg := ""
 
 	for _, scoreInfo := range summonerScores {
 		//判断是什么马
-		horse := scores.Judge(scoreInfo.Score)
+		horse := scoreInfo.HorseLabel()
 		//限制名字长度
 		name := utils.TruncateString(scoreInfo.SummonerName, 5)
 		//大乱斗玩家实力不详，特殊处理
 		if scoreInfo.IsARAM {
-			msg := fmt.Sprintf("%s\t[%s]-评分: %d 【大乱斗玩家,实力不详,遇弱则强,遇强则弱】——来自WeGame", name, horse, int(scoreInfo.Score))
+			msg := fmt.Sprintf("%s\t[%s|%s]%s%s-评分: %d 【大乱斗玩家,实力不详,遇弱则强,遇强则弱】——来自WeGame", name,
+				horse, scoreInfo.RankLabel(), scoreInfo.SmurfLabel(), scoreInfo.TagLabel(), int(scoreInfo.Score))
+			msg = script.FormatMessage(scoreInfo, msg)
 			MsgList = append(MsgList, msg)
 			allMsg += msg + "\n"
 			continue
@@ -154,18 +273,32 @@ func (ts *TalentScout) CalcTeamScore() {
 		}
 
 		//发送给客户端的数据
-		msg := fmt.Sprintf("%s\t[%s]-评分: %d 最近三场:%s ——来自WeGame", name, horse, int(scoreInfo.Score), currKDAMsg)
+		msg := fmt.Sprintf("%s\t[%s|%s]%s%s-评分: %d 最近三场:%s ——来自WeGame", name, horse, scoreInfo.RankLabel(),
+			scoreInfo.SmurfLabel(), scoreInfo.TagLabel(), int(scoreInfo.Score), currKDAMsg)
+		msg = script.FormatMessage(scoreInfo, msg)
 		MsgList = append(MsgList, msg)
 		//发送到命令行的数据
-		allMsg += fmt.Sprintf("%s\t[%s]-评分: %d 最近七场:%s\n ", name, horse, int(scoreInfo.Score), sevenKDAMsg)
+		allMsg += fmt.Sprintf("%s\t[%s]%s%s-评分: %d(%s)%s 单双:%s 灵活:%s 最近七场:%s\n ", name, horse,
+			scoreInfo.SmurfDetail(), scoreInfo.TagDetail(), int(scoreInfo.Score), scoreInfo.ConfidenceDetail(),
+			scoreInfo.CompareDetail(), scoreInfo.SoloRank.Detail(), scoreInfo.FlexRank.Detail(), sevenKDAMsg)
 	}
 	fmt.Println(allMsg)
-	//ts.PushMsgToMq(MsgList, sessionId)
-	SendMessage(MsgList, sessionId)
+	analysisDuration.Since(start, phaseChampSelect)
+	//秒退建议不等发送评分，发送要间隔好几秒，开黑时还要等队友的伯乐
+	go ts.adviseDodge(log, summonerScores)
+	//开黑时队友的伯乐已经发送了评分就只在本地显示
+	if ts.shouldSendReport(log, sessionId, announcedAt, summonerIDMapInfo) {
+		if ts.broker != nil {
+			ts.PushMsgToMq(MsgList, sessionId)
+		} else {
+			SendMessage(MsgList, sessionId)
+		}
+	}
 }
 
 // CalcEnemyTeamScore 计算敌方分数
 func (ts *TalentScout) CalcEnemyTeamScore() {
+	start := time.Now()
 	//游戏开始后拿到sessionID
 	session, err := lcu.QueryGameFlowSession()
 	if err != nil {
@@ -182,7 +315,8 @@ func (ts *TalentScout) CalcEnemyTeamScore() {
 	_, enemyTeamUsers := GetAllUsersFromSession(selfID, session)
 	summonerIDList := enemyTeamUsers
 
-	fmt.Println("敌方队伍人员列表:", summonerIDList)
+	log := logger.Lobby(ts.log, session.GameData.GameId, string(session.Phase))
+	log.Debug("敌方队伍人员列表", zap.Int64s("summonerIDs", summonerIDList))
 	if len(summonerIDList) == 0 {
 		return
 	}
@@ -192,16 +326,16 @@ func (ts *TalentScout) CalcEnemyTeamScore() {
 	mu := sync.Mutex{}
 	summonerIDMapInfo, err := listSummoner(summonerIDList)
 	if err != nil {
-		fmt.Println("查询敌方召唤师信息失败")
+		log.Error("查询敌方召唤师信息失败", zap.Error(err))
 		return
 	}
 	for _, summoner := range summonerIDMapInfo {
 		summoner := summoner
 		summonerID := summoner.SummonerId
 		g.Go(func() error {
-			actScore, err := GetUserScore(summoner)
+			actScore, err := GetUserScore(summoner, session.GameData.Queue.Id)
 			if err != nil {
-				fmt.Println("计算用户得分失败", zap.Error(err), zap.Int64("summonerID", summonerID))
+				log.Warn("计算用户得分失败", zap.Error(err), zap.Int64("summonerID", summonerID))
 				return nil
 			}
 			mu.Lock()
@@ -218,15 +352,19 @@ func (ts *TalentScout) CalcEnemyTeamScore() {
 	slices.SortFunc(summonerScores, func(a, b *scores.UserScore) int {
 		return cmp.Compare(b.Score, a.Score)
 	})
+	ts.checkPlayerNotes(summonerScores, notes.RelationEnemy)
+	ts.runLobbyScripts(summonerScores, notes.RelationEnemy, session.GameData.Queue.Id)
+	ts.sink.PublishLobby(mqtt.TopicEnemies, summonerScores)
 	// 根据所有用户的分数判断实力
 	allMsg := ""
 	for _, scoreInfo := range summonerScores {
 		name := utils.TruncateString(scoreInfo.SummonerName, 5)
-		horse := scores.Judge(scoreInfo.Score)
+		horse := scoreInfo.HorseLabel()
 		//大乱斗玩家特殊对待
 		if scoreInfo.IsARAM {
-			msg := fmt.Sprintf("%s\t[%s]-评分: %d 【大乱斗玩家,实力不详,遇弱则强,遇强则弱】", name, horse, int(scoreInfo.Score))
-			allMsg += msg + "\n"
+			msg := fmt.Sprintf("%s\t[%s|%s]%s%s-评分: %d 【大乱斗玩家,实力不详,遇弱则强,遇强则弱】", name, horse,
+				scoreInfo.RankLabel(), scoreInfo.SmurfDetail(), scoreInfo.TagDetail(), int(scoreInfo.Score))
+			allMsg += script.FormatMessage(scoreInfo, msg) + "\n"
 			continue
 		}
 		currKDASb := strings.Builder{}
@@ -235,41 +373,63 @@ func (ts *TalentScout) CalcEnemyTeamScore() {
 				scoreInfo.CurrKDA[i][2]))
 		}
 		currKDAMsg := currKDASb.String()
-		msg := fmt.Sprintf("%s\t[%s]-综合评分: %d 最近七场:%s", name, horse, int(scoreInfo.Score), currKDAMsg)
-		allMsg += msg + "\n"
+		msg := fmt.Sprintf("%s\t[%s]%s%s-综合评分: %d(%s)%s 单双:%s 灵活:%s 最近七场:%s", name, horse,
+			scoreInfo.SmurfDetail(), scoreInfo.TagDetail(), int(scoreInfo.Score), scoreInfo.ConfidenceDetail(),
+			scoreInfo.CompareDetail(), scoreInfo.SoloRank.Detail(), scoreInfo.FlexRank.Detail(), currKDAMsg)
+		allMsg += script.FormatMessage(scoreInfo, msg) + "\n"
 	}
 	fmt.Println(allMsg)
+	ts.printWinEstimate(summonerScores)
+	analysisDuration.Since(start, phaseInGame)
 }
 
-// PushMsgToMq 把消息发送给MQ
+// PushMsgToMq 把消息写入消息队列，由消费者按间隔发送，写入失败时直接发送
 func (ts *TalentScout) PushMsgToMq(msgList []string, sessionId string) {
-	m := "LOL伯乐正在寻找千里马...|" + sessionId
-	msgs := []string{m}
-	for _, msg := range msgList {
-		msgs = append(msgs, msg+"|"+sessionId)
+	msgs := append([]string{reportHeader}, msgList...)
+	published, err := mq.PublishChat(ts.ctx, ts.broker, config.Get().MQ.Queue, sessionId, msgs)
+	if err != nil {
+		// 已写入队列的消息由消费者发送，这里只直接发送剩下的，避免重复
+		ts.log.Warn("写入消息队列失败,直接发送剩余消息", zap.Error(err), zap.Int("published", published))
+		rest := msgs[published:]
+		sendMessages(rest[0], rest[1:], sessionId)
 	}
-	mq.Produce(ts.MqConn, msgs)
 }
 
 // AcceptGame 自动接受对局
 func (ts *TalentScout) AcceptGame() {
-	if ts.autoAccept {
-		err := acceptGame()
-		if err != nil {
-			return
-		}
-		fmt.Println("已自动接受对局,不允许临阵脱逃噢")
+	ts.mu.Lock()
+	autoAccept := ts.autoAccept
+	ts.mu.Unlock()
+	result := "accepted"
+	defer func() {
+		autoAccepts.Inc(result)
+		ts.sink.PublishReadyCheck(result)
+	}()
+	if !autoAccept {
+		result = "skipped"
+		return
+	}
+	if err := acceptGame(); err != nil {
+		result = "error"
+		return
 	}
+	fmt.Println("已自动接受对局,不允许临阵脱逃噢")
 }
 
 // onGameFlowUpdate 根据客户端推送的信息，实时更新客户端状态
 func (ts *TalentScout) onGameFlowUpdate(gameFlow string) {
-	fmt.Println("当前状态:" + gameFlow)
+	ts.log.Info("客户端状态变化", zap.String("phase", gameFlow))
+	if gameFlow != string(models.GameFlowInProgress) {
+		ts.stopLiveGame()
+	}
 	switch gameFlow {
 
 	// 英雄选择状态
 	case string(models.GameFlowChampionSelect):
 		fmt.Println("进入英雄选择阶段,正在计算用户分数")
+		ts.mu.Lock()
+		ts.locked = lockedPick{}
+		ts.mu.Unlock()
 		ts.updateGameState(GameStateChampSelect)
 		go ts.CalcTeamScore() //开个协程去计算队友分数
 	// 大厅等待状态
@@ -285,6 +445,13 @@ func (ts *TalentScout) onGameFlowUpdate(gameFlow string) {
 		fmt.Println("已进入游戏,正在计算敌方分数")
 		ts.updateGameState(GameStateInGame)
 		go ts.CalcEnemyTeamScore() //开个协程去计算敌方分数
+		go ts.trackLiveGame()      //轮询游戏内数据
+
+	// 对局结算状态
+	case string(models.GameFlowEndOfGame):
+		fmt.Println("对局已结束,正在生成赛后报告")
+		ts.updateGameState(GameStateEndOfGame)
+		go ts.AnalyzePostGame() //开个协程去做赛后分析
 
 	//对局确认状态
 	case string(models.GameFlowReadyCheck):
@@ -315,6 +482,11 @@ func (ts *TalentScout) InitGameFlowMonitor(port int, token string) error {
 	defer func() {
 		_ = c.Close()
 	}()
+	//退出时关闭连接，结束下面的读取
+	stop := context.AfterFunc(ts.ctx, func() {
+		_ = c.Close()
+	})
+	defer stop()
 	//使用了 retry 包来尝试多次调用 lcu.GetCurrSummoner() 函数，目的是获取当前召唤师（currSummoner）的信息
 	err = retry.Do(func() error {
 		currSummoner, err := lcu.GetCurrSummoner()
@@ -327,13 +499,28 @@ func (ts *TalentScout) InitGameFlowMonitor(port int, token string) error {
 		return errors.New("获取当前召唤师信息失败:" + err.Error())
 	}
 	//如果获取到了召唤师信息则LCU客户端连接成功，把状态设置为活跃
+	ts.mu.Lock()
 	ts.lcuActive = true
+	rebuild := ts.encountersFor != ts.currSummoner.Puuid
+	ts.encountersFor = ts.currSummoner.Puuid
+	ts.mu.Unlock()
+	//启动后第一次连上客户端时从缓存的对局中补全相遇记录，之后由赛后记录增量更新，重连时不再重复生成
+	if rebuild {
+		go func(puuid string) {
+			if _, err := notes.RebuildEncounters(puuid); err != nil {
+				ts.log.Warn("生成相遇记录失败", zap.Error(err))
+			}
+		}(ts.currSummoner.Puuid)
+	}
 	//向客户端发送[5, "OnJsonApiEvent"],请求交互信息
 	_ = c.WriteMessage(websocket.TextMessage, []byte("[5, \"OnJsonApiEvent\"]"))
 	for {
 		msgType, message, err := c.ReadMessage()
 		if err != nil {
-			fmt.Println("lol事件监控读取消息失败", zap.Error(err))
+			if ts.ctx.Err() != nil {
+				return nil
+			}
+			ts.log.Warn("lol事件监控读取消息失败", zap.Error(err))
 			return err
 		}
 		msg := &wsMsg{}
@@ -353,33 +540,103 @@ func (ts *TalentScout) InitGameFlowMonitor(port int, token string) error {
 			ts.onGameFlowUpdate(gameFlow)
 		case string(champSelectUpdateSessionEvt): //选择英雄阶段信息（皮肤，召唤师技能，骰子等）
 			//TODO 一键ban/pick
+			ts.onChampSelectUpdate(msg.Data)
 		default:
 		}
 	}
 }
 
-// Run 启动TalentScout
+// Run 启动TalentScout，收到SIGINT/SIGTERM后退出
 func (ts *TalentScout) Run() {
-	//开启监听
-	//go mq.Listen(ts.MqConn)
+	conf := config.Get()
+	//开启消息队列消费者
+	if ts.broker != nil {
+		go func() {
+			if err := mq.Listen(ts.ctx, ts.broker, conf.MQ, lcu.SendConversationMsg); err != nil {
+				ts.log.Error("消息队列消费者退出", zap.Error(err))
+			}
+		}()
+	}
+	if ts.headless && conf.Headless.LogFile != "" {
+		closeLog, err := teeStdout(conf.Headless.LogFile)
+		if err != nil {
+			ts.log.Error("打开日志文件失败", zap.Error(err))
+		} else {
+			defer closeLog()
+		}
+	}
+	if conf.API.Addr != "" {
+		if err := ts.startAPI(conf.API.Addr); err != nil {
+			ts.log.Error("开启HTTP接口失败", zap.Error(err))
+		}
+	}
 	//开启通知栏设置中心
-	go systray.Run(checkBox.OnStart, checkBox.OnExit)
+	ts.ui.Start(ts.autoAccept, ts.updateAutoAccept)
+	go ts.waitSignal()
 	//重连次数
 	connection := 1
-	for {
-		//如果没有连上客户端
-		if !ts.lcuActive {
-			//获取LCU客户端token和port
-			token, port := initialize.NewCertificate()
-			//先持久化一个客户端连接
-			lcu.InitCli(port, token)
-			//基于wss与客户端建立一个实时通讯
-			err := ts.InitGameFlowMonitor(port, token)
-			if err != nil {
-				fmt.Println(fmt.Sprintf("未检测到LOL客户端，正在尝试重连......[重连次数:%d]", connection))
-				connection++
-				time.Sleep(5 * time.Second)
-			}
+	for ts.ctx.Err() == nil {
+		//获取LCU客户端token和port，命令行指定了就不再从客户端进程读取
+		token, port := ts.lcuToken, ts.lcuPort
+		if token == "" || port == 0 {
+			token, port = initialize.NewCertificate()
 		}
+		//先持久化一个客户端连接
+		lcu.InitCli(port, token)
+		//基于wss与客户端建立一个实时通讯，断开后重连
+		err := ts.InitGameFlowMonitor(port, token)
+		ts.mu.Lock()
+		ts.lcuActive = false
+		ts.mu.Unlock()
+		if ts.ctx.Err() != nil {
+			break
+		}
+		if err != nil {
+			ts.log.Debug("连接客户端失败", zap.Error(err), zap.Int("port", port))
+			fmt.Println(fmt.Sprintf("未检测到LOL客户端，正在尝试重连......[重连次数:%d]", connection))
+			connection++
+		}
+		select {
+		case <-ts.ctx.Done():
+		case <-time.After(5 * time.Second):
+			wsReconnects.Inc()
+		}
+	}
+	ts.shutdown()
+}
+
+// waitSignal 收到退出信号后取消ctx，让Run退出
+func (ts *TalentScout) waitSignal() {
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(sigCh)
+	select {
+	case sig := <-sigCh:
+		ts.log.Info("收到退出信号", zap.Stringer("signal", sig))
+		ts.cancel()
+	case <-ts.ctx.Done():
+	}
+}
+
+// Stop 停止TalentScout，Run会在清理后返回
+func (ts *TalentScout) Stop() {
+	ts.cancel()
+}
+
+// shutdown 关闭HTTP接口和界面
+func (ts *TalentScout) shutdown() {
+	if ts.httpSrv != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := ts.httpSrv.Shutdown(ctx); err != nil {
+			ts.log.Error("关闭HTTP接口失败", zap.Error(err))
+		}
+	}
+	if ts.broker != nil {
+		_ = ts.broker.Close()
 	}
+	ts.sink.Close()
+	ts.ui.Stop()
+	fmt.Println("伯乐已退出")
+	logger.Sync()
 }
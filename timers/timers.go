@@ -0,0 +1,305 @@
+package timers
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"main.go/liveclient"
+)
+
+const (
+	KindObjective = "objective" // 野怪刷新
+	KindSpell     = "spell"     // 召唤师技能冷却
+)
+
+const (
+	dragonRespawn = 300 // 小龙击杀后5分钟刷新
+	elderRespawn  = 360 // 远古龙击杀后(或拿到龙魂后)6分钟刷新
+	baronRespawn  = 360 // 男爵击杀后6分钟刷新
+
+	soulDragons        = 4    // 一方拿到4条元素龙获得龙魂，之后只刷远古龙
+	cosmicInsightHaste = 18   // 星界洞悉提供的召唤师技能急速
+	ionianBootsHaste   = 10   // 明朗之靴提供的召唤师技能急速
+	ionianBootsID      = 3158 // 明朗之靴
+	inspirationTreeID  = 8300 // 启迪系
+)
+
+// spellCooldowns 召唤师技能的基础冷却(秒)
+var spellCooldowns = map[string]float64{
+	"SummonerFlash":    300,
+	"SummonerTeleport": 360,
+	"SummonerDot":      180,
+	"SummonerExhaust":  210,
+	"SummonerHeal":     240,
+	"SummonerBarrier":  180,
+	"SummonerBoost":    210,
+	"SummonerHaste":    210,
+	"SummonerSmite":    90,
+}
+
+// spellNames 召唤师技能的中文名，也可以用来标记技能
+var spellNames = map[string]string{
+	"SummonerFlash":    "闪现",
+	"SummonerTeleport": "传送",
+	"SummonerDot":      "点燃",
+	"SummonerExhaust":  "虚弱",
+	"SummonerHeal":     "治疗",
+	"SummonerBarrier":  "屏障",
+	"SummonerBoost":    "净化",
+	"SummonerHaste":    "疾步",
+	"SummonerSmite":    "惩戒",
+}
+
+// spellAliases 标记技能时可以用的简写
+var spellAliases = map[string]string{
+	"flash":    "SummonerFlash",
+	"f":        "SummonerFlash",
+	"tp":       "SummonerTeleport",
+	"teleport": "SummonerTeleport",
+	"ignite":   "SummonerDot",
+	"exhaust":  "SummonerExhaust",
+	"heal":     "SummonerHeal",
+	"barrier":  "SummonerBarrier",
+	"cleanse":  "SummonerBoost",
+	"ghost":    "SummonerHaste",
+}
+
+var (
+	ErrNoEnemy = errors.New("找不到该敌方玩家")
+	ErrNoSpell = errors.New("该玩家没有这个召唤师技能")
+)
+
+type (
+	// Timer 一个计时，时间都是游戏内秒数
+	Timer struct {
+		Kind      string  `json:"kind"`
+		Label     string  `json:"label"`              // 显示的名字，例如 男爵、阿狸闪现
+		Champion  string  `json:"champion,omitempty"` // 召唤师技能所属的英雄
+		Spell     string  `json:"spell,omitempty"`    // 召唤师技能key
+		ReadyAt   float64 `json:"readyAt"`
+		Remaining float64 `json:"remaining"`
+	}
+	// Enemy 敌方玩家的召唤师技能和技能急速
+	Enemy struct {
+		Name     string    `json:"name"`
+		Champion string    `json:"champion"`
+		Spells   [2]string `json:"spells"`
+		Haste    float64   `json:"haste"`
+	}
+)
+
+// Tracker 游戏内计时：按事件计算小龙、男爵的刷新时间(14.1之后先锋只在14:00刷新一次，击杀后不再刷新)，按手动标记计算敌方召唤师技能冷却
+type Tracker struct {
+	mu           sync.Mutex
+	assumeCosmic bool // 接口只提供敌方的基石和符文系，带启迪系时是否按带了星界洞悉计算
+	gameTime     float64
+	polledAt     time.Time
+	enemies      []Enemy
+	objectives   map[string]float64 // 名字 -> 刷新时间
+	spells       map[string]Timer   // 玩家+技能 -> 计时
+}
+
+// New 创建一局游戏的计时
+func New(assumeCosmicInsight bool) *Tracker {
+	return &Tracker{
+		assumeCosmic: assumeCosmicInsight,
+		objectives:   map[string]float64{},
+		spells:       map[string]Timer{},
+	}
+}
+
+// Update 用最新的游戏数据更新敌方技能急速，用新事件计算野怪刷新
+func (t *Tracker) Update(data *liveclient.AllGameData, snapshot liveclient.Snapshot, events []liveclient.Event) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.gameTime = data.GameData.GameTime
+	t.polledAt = time.Now()
+	t.enemies = t.enemies[:0]
+	for _, p := range data.AllPlayers {
+		if snapshot.SelfTeam == "" || p.Team == snapshot.SelfTeam {
+			continue
+		}
+		t.enemies = append(t.enemies, Enemy{
+			Name:     p.Name(),
+			Champion: p.ChampionName,
+			Spells:   [2]string{p.SummonerSpells.One.Key(), p.SummonerSpells.Two.Key()},
+			Haste:    t.spellHaste(p),
+		})
+	}
+	soul := false
+	for _, team := range snapshot.Teams {
+		elemental := 0
+		for _, dragon := range team.Dragons {
+			if dragon != "Elder" {
+				elemental++
+			}
+		}
+		soul = soul || elemental >= soulDragons
+	}
+	for _, e := range events {
+		switch e.EventName {
+		case liveclient.EventDragonKill:
+			delete(t.objectives, "小龙")
+			if soul || e.DragonType == "Elder" {
+				t.objectives["远古龙"] = e.EventTime + elderRespawn
+			} else {
+				t.objectives["小龙"] = e.EventTime + dragonRespawn
+			}
+		case liveclient.EventBaronKill:
+			t.objectives["男爵"] = e.EventTime + baronRespawn
+		}
+	}
+}
+
+// spellHaste 召唤师技能急速，星界洞悉只能按符文系推测
+func (t *Tracker) spellHaste(p liveclient.Player) float64 {
+	haste := 0.0
+	inspiration := p.Runes.PrimaryRuneTree.ID == inspirationTreeID || p.Runes.SecondaryRuneTree.ID == inspirationTreeID
+	if t.assumeCosmic && inspiration {
+		haste += cosmicInsightHaste
+	}
+	for _, item := range p.Items {
+		if item.ItemID == ionianBootsID {
+			haste += ionianBootsHaste
+			break
+		}
+	}
+	return haste
+}
+
+// Enemies 敌方玩家，序号从1开始对应标记时的序号
+func (t *Tracker) Enemies() []Enemy {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return slices.Clone(t.enemies)
+}
+
+// MarkSpell 标记敌方交了召唤师技能，从现在开始计算冷却
+// target为敌方序号(1开始)、英雄名或玩家名；spell为空时优先闪现，other为另一个技能，1/2表示第几个技能，也可以是flash、tp、闪现等
+func (t *Tracker) MarkSpell(target, spell string) (Timer, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	enemy, ok := t.findEnemy(target)
+	if !ok {
+		return Timer{}, fmt.Errorf("%w: %s", ErrNoEnemy, target)
+	}
+	key, ok := pickSpell(enemy, spell)
+	if !ok {
+		return Timer{}, fmt.Errorf("%w: %s %s", ErrNoSpell, enemy.Champion, spell)
+	}
+	cooldown, ok := spellCooldowns[key]
+	if !ok {
+		return Timer{}, fmt.Errorf("%w: %s", ErrNoSpell, key)
+	}
+	now := t.now()
+	timer := Timer{
+		Kind:     KindSpell,
+		Label:    enemy.Champion + spellName(key),
+		Champion: enemy.Champion,
+		Spell:    key,
+		ReadyAt:  now + cooldown*100/(100+enemy.Haste),
+	}
+	timer.Remaining = timer.ReadyAt - now
+	t.spells[enemy.Name+"/"+key] = timer
+	return timer, nil
+}
+
+// Timers 还没到时间的计时，按到时间的先后排序
+func (t *Tracker) Timers() []Timer {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	now := t.now()
+	timers := make([]Timer, 0, len(t.objectives)+len(t.spells))
+	for label, readyAt := range t.objectives {
+		if readyAt > now {
+			timers = append(timers, Timer{Kind: KindObjective, Label: label, ReadyAt: readyAt, Remaining: readyAt - now})
+		}
+	}
+	for _, timer := range t.spells {
+		if timer.ReadyAt > now {
+			timer.Remaining = timer.ReadyAt - now
+			timers = append(timers, timer)
+		}
+	}
+	slices.SortFunc(timers, func(a, b Timer) int {
+		return cmp.Compare(a.ReadyAt, b.ReadyAt)
+	})
+	return timers
+}
+
+// ChatLine 可以直接粘贴到游戏聊天的一行，例如 "阿狸闪现12:30 男爵26:00"
+func (t *Tracker) ChatLine() string {
+	timers := t.Timers()
+	parts := make([]string, 0, len(timers))
+	for _, timer := range timers {
+		parts = append(parts, timer.Label+liveclient.FormatGameTime(timer.ReadyAt))
+	}
+	return strings.Join(parts, " ")
+}
+
+// now 当前游戏时间，两次轮询之间按实际经过的时间推算
+func (t *Tracker) now() float64 {
+	if t.polledAt.IsZero() {
+		return t.gameTime
+	}
+	return t.gameTime + time.Since(t.polledAt).Seconds()
+}
+
+func (t *Tracker) findEnemy(target string) (Enemy, bool) {
+	if i, err := strconv.Atoi(target); err == nil {
+		if i < 1 || i > len(t.enemies) {
+			return Enemy{}, false
+		}
+		return t.enemies[i-1], true
+	}
+	for _, enemy := range t.enemies {
+		if strings.EqualFold(enemy.Champion, target) || strings.EqualFold(enemy.Name, target) ||
+			liveclient.SamePlayer(target, enemy.Name) {
+			return enemy, true
+		}
+	}
+	return Enemy{}, false
+}
+
+// pickSpell 找到要标记的技能key
+func pickSpell(enemy Enemy, spell string) (string, bool) {
+	switch spell {
+	case "":
+		if slices.Contains(enemy.Spells[:], "SummonerFlash") {
+			return "SummonerFlash", true
+		}
+		return enemy.Spells[0], enemy.Spells[0] != ""
+	case "other":
+		first, _ := pickSpell(enemy, "")
+		if enemy.Spells[0] == first {
+			return enemy.Spells[1], enemy.Spells[1] != ""
+		}
+		return enemy.Spells[0], enemy.Spells[0] != ""
+	case "1", "2":
+		key := enemy.Spells[spell[0]-'1']
+		return key, key != ""
+	}
+	key, ok := spellAliases[strings.ToLower(spell)]
+	if !ok {
+		key = spell
+		for k, name := range spellNames {
+			if name == spell {
+				key = k
+			}
+		}
+	}
+	return key, slices.Contains(enemy.Spells[:], key)
+}
+
+func spellName(key string) string {
+	if name, ok := spellNames[key]; ok {
+		return name
+	}
+	return key
+}
@@ -0,0 +1,82 @@
+package LOLTalentScout
+
+import (
+	"fmt"
+	"main.go/logger"
+	"sync"
+	"time"
+)
+
+// UI 和用户交互的界面，通知栏和无界面模式各自实现
+type UI interface {
+	// Start 启动界面，autoAccept为自动接受对局的初始状态，用户切换时调用onAutoAccept
+	Start(autoAccept bool, onAutoAccept func(bool))
+	// Stop 关闭界面
+	Stop()
+	// SetAutoAccept 通过HTTP接口修改自动接受对局后同步界面状态
+	SetAutoAccept(autoAccept bool)
+	// Notify 展示玩家提醒
+	Notify(msg string)
+	// AskConfirm 请求用户确认，超时未确认返回false
+	AskConfirm(title string, timeout time.Duration) bool
+}
+
+// headlessUI 无界面模式，提醒只输出到日志，确认操作通过HTTP接口完成
+type headlessUI struct {
+	mu      sync.Mutex
+	pending string        // 等待确认的操作
+	confirm chan struct{} // 收到确认
+}
+
+func newHeadlessUI() *headlessUI {
+	return &headlessUI{confirm: make(chan struct{})}
+}
+
+func (h *headlessUI) Start(bool, func(bool)) {
+	logger.L().Info("无界面模式运行")
+}
+
+func (h *headlessUI) Stop() {
+}
+
+func (h *headlessUI) SetAutoAccept(bool) {
+}
+
+// Notify 提醒已经输出到日志，这里不需要再处理
+func (h *headlessUI) Notify(string) {
+}
+
+func (h *headlessUI) AskConfirm(title string, timeout time.Duration) bool {
+	h.mu.Lock()
+	h.pending = title
+	h.mu.Unlock()
+	defer func() {
+		h.mu.Lock()
+		h.pending = ""
+		h.mu.Unlock()
+	}()
+	fmt.Printf("等待确认:%s,%d秒内通过 ctl confirm 或 POST /api/confirm 确认\n", title, int(timeout.Seconds()))
+	select {
+	case <-h.confirm:
+		return true
+	case <-time.After(timeout):
+		return false
+	}
+}
+
+// Pending 等待确认的操作，没有时为空
+func (h *headlessUI) Pending() string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.pending
+}
+
+// Confirm 确认等待中的操作，没有等待中的操作时返回false
+func (h *headlessUI) Confirm() bool {
+	select {
+	case h.confirm <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
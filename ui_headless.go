@@ -0,0 +1,8 @@
+//go:build headless
+
+package LOLTalentScout
+
+// newTrayUI 用 -tags headless 编译时不包含通知栏，只能以无界面模式运行
+func newTrayUI() UI {
+	return nil
+}
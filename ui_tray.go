@@ -0,0 +1,36 @@
+//go:build !headless
+
+package LOLTalentScout
+
+import (
+	"main.go/checkBox"
+	"time"
+)
+
+// trayUI 通知栏界面
+type trayUI struct{}
+
+// newTrayUI 通知栏界面，用 -tags headless 编译时不可用
+func newTrayUI() UI {
+	return trayUI{}
+}
+
+func (trayUI) Start(autoAccept bool, onAutoAccept func(bool)) {
+	checkBox.Start(autoAccept, onAutoAccept)
+}
+
+func (trayUI) Stop() {
+	checkBox.Stop()
+}
+
+func (trayUI) SetAutoAccept(autoAccept bool) {
+	checkBox.SetAccept(autoAccept)
+}
+
+func (trayUI) Notify(msg string) {
+	checkBox.Notify(msg)
+}
+
+func (trayUI) AskConfirm(title string, timeout time.Duration) bool {
+	return checkBox.AskConfirm(title, timeout)
+}